@@ -170,6 +170,29 @@ func ChainOptionK[A, B any](f option.Kleisli[A, []B]) Operator[A, B] {
 	return G.ChainOptionK[[]A](f)
 }
 
+// FilterMapWithErrors maps an array with an iterating function that returns an [Option]. In contrast to [FilterMap]
+// it reports both the indices of the elements that were dropped because the function returned None (the head of the
+// pair) and the values of the Somes (the tail of the pair). Both sides preserve the order of the input.
+//
+// Example:
+//
+//	parse := FilterMapWithErrors(option.ParseWith(strconv.Atoi))
+//	res := parse([]string{"1", "x", "3"}) // Pair([1], [1, 3])
+func FilterMapWithErrors[A, B any](f option.Kleisli[A, B]) func([]A) pair.Pair[[]int, []B] {
+	return func(fa []A) pair.Pair[[]int, []B] {
+		dropped := make([]int, 0)
+		kept := make([]B, 0, len(fa))
+		for i, a := range fa {
+			if b, ok := option.Unwrap(f(a)); ok {
+				kept = append(kept, b)
+			} else {
+				dropped = append(dropped, i)
+			}
+		}
+		return pair.MakePair(dropped, kept)
+	}
+}
+
 // FilterMapRef filters an array using a predicate on pointers and maps the matching elements using a function on pointers.
 func FilterMapRef[A, B any](pred func(a *A) bool, f func(*A) B) Operator[A, B] {
 	return func(fa []A) []B {
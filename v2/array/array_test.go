@@ -1102,3 +1102,38 @@ func TestConcatProperties(t *testing.T) {
 		assert.Equal(t, copy2, original2)
 	})
 }
+
+func TestFilterMapWithErrors(t *testing.T) {
+	parse := FilterMapWithErrors(O.ParseWith(func(s string) (int, error) {
+		var n int
+		_, err := fmt.Sscanf(s, "%d", &n)
+		return n, err
+	}))
+
+	src := []string{"1", "x", "3", "y"}
+	assert.Equal(t, pair.MakePair([]int{1, 3}, []int{1, 3}), parse(src))
+	// input is not mutated
+	assert.Equal(t, []string{"1", "x", "3", "y"}, src)
+
+	// none kept
+	assert.Equal(t, pair.MakePair([]int{0, 1}, []int{}), parse([]string{"a", "b"}))
+	// all kept
+	assert.Equal(t, pair.MakePair([]int{}, []int{4, 5}), parse([]string{"4", "5"}))
+	// empty
+	assert.Equal(t, pair.MakePair([]int{}, []int{}), parse(nil))
+}
+
+func TestFilterMapKeepsOrder(t *testing.T) {
+	half := func(n int) O.Option[int] {
+		if n%2 == 0 {
+			return O.Some(n / 2)
+		}
+		return O.None[int]()
+	}
+
+	assert.Equal(t, []int{3, 1, 2}, FilterMap(half)([]int{6, 1, 2, 5, 4}))
+	assert.Equal(t, []int{}, FilterMap(half)([]int{1, 3}))
+	assert.Equal(t, []string{"0:6", "2:2"}, FilterMapWithIndex(func(i, n int) O.Option[string] {
+		return O.Map(func(int) string { return fmt.Sprintf("%d:%d", i, n) })(half(n))
+	})([]int{6, 1, 2}))
+}
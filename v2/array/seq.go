@@ -0,0 +1,43 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"slices"
+)
+
+// FromSeq collects the elements of a sequence into an array.
+// The result is never nil, an empty sequence yields an empty array.
+//
+// Example:
+//
+//	as := FromSeq(maps.Keys(m))
+func FromSeq[A any](seq Seq[A]) []A {
+	return slices.AppendSeq(make([]A, 0), seq)
+}
+
+// ToSeq converts an array into a sequence that yields its elements in order.
+//
+// Example:
+//
+//	for a := range ToSeq([]int{1, 2, 3}) {
+//	    fmt.Println(a)
+//	}
+//
+//go:inline
+func ToSeq[A any](as []A) Seq[A] {
+	return slices.Values(as)
+}
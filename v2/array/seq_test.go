@@ -0,0 +1,44 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSeq(t *testing.T) {
+	gen := func(yield func(int) bool) {
+		for i := range 3 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2}, FromSeq(gen))
+
+	empty := FromSeq(slices.Values([]int(nil)))
+	assert.NotNil(t, empty)
+	assert.Empty(t, empty)
+}
+
+func TestToSeq(t *testing.T) {
+	as := []string{"a", "b", "c"}
+	assert.Equal(t, as, slices.Collect(ToSeq(as)))
+	assert.Equal(t, as, FromSeq(ToSeq(as)))
+}
@@ -1,6 +1,10 @@
 package array
 
-import "github.com/IBM/fp-go/v2/option"
+import (
+	"iter"
+
+	"github.com/IBM/fp-go/v2/option"
+)
 
 type (
 	// Kleisli represents a Kleisli arrow for arrays.
@@ -13,4 +17,8 @@ type (
 
 	// Option represents an optional value that may or may not be present.
 	Option[A any] = option.Option[A]
+
+	// Seq represents an iterator sequence over values of type A.
+	// It's an alias for Go's standard iter.Seq[A] type.
+	Seq[A any] = iter.Seq[A]
 )
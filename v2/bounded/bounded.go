@@ -15,7 +15,12 @@
 
 package bounded
 
-import "github.com/IBM/fp-go/v2/ord"
+import (
+	"math"
+	"time"
+
+	"github.com/IBM/fp-go/v2/ord"
+)
 
 type Bounded[T any] interface {
 	ord.Ord[T]
@@ -60,3 +65,9 @@ func Clamp[T any](b Bounded[T]) func(T) T {
 func Reverse[T any](b Bounded[T]) Bounded[T] {
 	return MakeBounded(ord.Reverse(b), b.Bottom(), b.Top())
 }
+
+// Duration returns the bounded instance for [time.Duration] ranging from the smallest
+// to the largest representable duration
+func Duration() Bounded[time.Duration] {
+	return MakeBounded(ord.Duration(), time.Duration(math.MaxInt64), time.Duration(math.MinInt64))
+}
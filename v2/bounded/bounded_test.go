@@ -16,7 +16,9 @@
 package bounded
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/IBM/fp-go/v2/ord"
 	"github.com/stretchr/testify/assert"
@@ -210,3 +212,12 @@ func ExampleReverse() {
 
 	// Output:
 }
+
+func TestDuration(t *testing.T) {
+	b := Duration()
+
+	assert.Equal(t, time.Duration(math.MaxInt64), b.Top())
+	assert.Equal(t, time.Duration(math.MinInt64), b.Bottom())
+	assert.Equal(t, -1, b.Compare(time.Second, time.Minute))
+	assert.Equal(t, time.Minute, Clamp(b)(time.Minute))
+}
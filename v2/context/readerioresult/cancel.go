@@ -21,6 +21,7 @@ import (
 	CIOE "github.com/IBM/fp-go/v2/context/ioresult"
 	F "github.com/IBM/fp-go/v2/function"
 	"github.com/IBM/fp-go/v2/ioeither"
+	"github.com/IBM/fp-go/v2/result"
 )
 
 // WithContext wraps an existing [ReaderIOResult] and performs a context check for cancellation before delegating.
@@ -85,3 +86,71 @@ func WithContextK[A, B any](f Kleisli[A, B]) Kleisli[A, B] {
 		WithContext,
 	)
 }
+
+// WithCancelCause runs a [ReaderIOResult] in a child context that is cancelled as soon as the
+// computation completes. The cancellation cause is computed from the result of the computation,
+// so goroutines started by the computation that still observe the child context can find out
+// why it ended via [context.Cause].
+//
+// The parent context is never cancelled. If the computation fails with the plain error of the
+// child context, the error is replaced by the cause recorded on that context.
+//
+// Parameters:
+//   - cause: Computes the cancellation cause from the result of the computation
+//
+// Returns:
+//   - An Operator that runs the computation in a child context
+//
+// Example:
+//
+//	errDone := errors.New("request finished")
+//	handled := F.Pipe1(
+//	    handleRequest,
+//	    WithCancelCause(func(Result[Response]) error { return errDone }),
+//	)
+//	// background work started by handleRequest sees context.Cause(ctx) == errDone
+func WithCancelCause[A any](cause func(Result[A]) error) Operator[A, A] {
+	return func(rr ReaderIOResult[A]) ReaderIOResult[A] {
+		return func(ctx context.Context) IOResult[A] {
+			return func() Result[A] {
+				if ctx.Err() != nil {
+					return result.Left[A](context.Cause(ctx))
+				}
+				child, cancel := context.WithCancelCause(ctx)
+				// release the context even if the computation panics, the first cause wins
+				defer cancel(nil)
+				res := leftCause(child, rr(child)())
+				cancel(cause(res))
+				return res
+			}
+		}
+	}
+}
+
+// localWithCause is like [Local] but reports the cause of the derived context if the
+// computation fails with the plain context error
+func localWithCause[A any](f func(context.Context) (context.Context, context.CancelFunc)) Operator[A, A] {
+	return F.Flow2(
+		withLeftCause[A],
+		Local[A](f),
+	)
+}
+
+// withLeftCause applies [leftCause] to the result of the computation, using the context the computation ran with
+func withLeftCause[A any](rr ReaderIOResult[A]) ReaderIOResult[A] {
+	return func(ctx context.Context) IOResult[A] {
+		return func() Result[A] {
+			return leftCause(ctx, rr(ctx)())
+		}
+	}
+}
+
+// leftCause replaces a Left that holds the plain error of a done context by the cause of that context
+func leftCause[A any](ctx context.Context, res Result[A]) Result[A] {
+	if err := ctx.Err(); err != nil {
+		if _, e := result.Unwrap(res); e == err {
+			return result.Left[A](context.Cause(ctx))
+		}
+	}
+	return res
+}
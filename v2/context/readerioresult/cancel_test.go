@@ -0,0 +1,144 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readerioresult
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// slowFake waits for the given duration or until the context is done
+func slowFake(d time.Duration) ReaderIOResult[string] {
+	return func(ctx context.Context) IOResult[string] {
+		return func() Result[string] {
+			select {
+			case <-time.After(d):
+				return result.Of("done")
+			case <-ctx.Done():
+				return result.Left[string](ctx.Err())
+			}
+		}
+	}
+}
+
+func TestWithTimeoutDeadline(t *testing.T) {
+	parent := t.Context()
+
+	t.Run("fails with deadline exceeded", func(t *testing.T) {
+		res := F.Pipe1(
+			slowFake(time.Second),
+			WithTimeout[string](10*time.Millisecond),
+		)(parent)()
+
+		_, err := result.Unwrap(res)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NoError(t, parent.Err())
+	})
+
+	t.Run("succeeds within the timeout", func(t *testing.T) {
+		res := F.Pipe1(
+			slowFake(time.Millisecond),
+			WithTimeout[string](time.Second),
+		)(parent)()
+
+		assert.Equal(t, result.Of("done"), res)
+		assert.NoError(t, parent.Err())
+	})
+
+	t.Run("reports the cause of the parent", func(t *testing.T) {
+		errShutdown := errors.New("shutdown")
+		ctx, cancel := context.WithCancelCause(parent)
+		time.AfterFunc(10*time.Millisecond, func() { cancel(errShutdown) })
+
+		res := F.Pipe1(
+			slowFake(time.Second),
+			WithTimeout[string](time.Second),
+		)(ctx)()
+
+		_, err := result.Unwrap(res)
+		assert.Equal(t, errShutdown, err)
+	})
+}
+
+func TestWithCancelCause(t *testing.T) {
+	errDone := errors.New("done")
+
+	t.Run("records the cause on the child context", func(t *testing.T) {
+		parent := t.Context()
+		causes := make(chan error, 1)
+
+		background := func(ctx context.Context) IOResult[int] {
+			return func() Result[int] {
+				go func() {
+					<-ctx.Done()
+					causes <- context.Cause(ctx)
+				}()
+				return result.Of(42)
+			}
+		}
+
+		var seen Result[int]
+		res := F.Pipe1(
+			background,
+			WithCancelCause(func(r Result[int]) error {
+				seen = r
+				return errDone
+			}),
+		)(parent)()
+
+		assert.Equal(t, result.Of(42), res)
+		assert.Equal(t, res, seen)
+		assert.Equal(t, errDone, <-causes)
+		assert.NoError(t, parent.Err())
+	})
+
+	t.Run("translates the parent cause into Left", func(t *testing.T) {
+		errShutdown := errors.New("shutdown")
+		ctx, cancel := context.WithCancelCause(t.Context())
+		time.AfterFunc(10*time.Millisecond, func() { cancel(errShutdown) })
+
+		res := F.Pipe1(
+			slowFake(time.Second),
+			WithCancelCause(func(Result[string]) error { return errDone }),
+		)(ctx)()
+
+		_, err := result.Unwrap(res)
+		assert.Equal(t, errShutdown, err)
+	})
+
+	t.Run("does not run on a cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		called := false
+		res := F.Pipe1(
+			slowFake(time.Second),
+			WithCancelCause(func(Result[string]) error {
+				called = true
+				return errDone
+			}),
+		)(ctx)()
+
+		assert.True(t, result.IsLeft(res))
+		assert.False(t, called)
+	})
+}
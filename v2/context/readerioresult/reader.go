@@ -1079,7 +1079,9 @@ func Local[A any](f func(context.Context) (context.Context, context.CancelFunc))
 // The timeout is relative to when the ReaderIOResult is executed, not when
 // WithTimeout is called. The cancel function is automatically called when
 // the computation completes, ensuring proper cleanup. If the timeout expires,
-// the computation will receive a context.DeadlineExceeded error.
+// the computation will receive a context.DeadlineExceeded error. A computation
+// that fails with the plain context error reports the cause of the derived
+// context instead, see [context.Cause].
 //
 // Type Parameters:
 //   - A: The value type of the ReaderIOResult
@@ -1123,7 +1125,7 @@ func Local[A any](f func(context.Context) (context.Context, context.CancelFunc))
 //	)
 //	value, err := result(t.Context())()  // Returns (Data{Value: "quick"}, nil)
 func WithTimeout[A any](timeout time.Duration) Operator[A, A] {
-	return Local[A](func(ctx context.Context) (context.Context, context.CancelFunc) {
+	return localWithCause[A](func(ctx context.Context) (context.Context, context.CancelFunc) {
 		return context.WithTimeout(ctx, timeout)
 	})
 }
@@ -1188,7 +1190,7 @@ func WithTimeout[A any](timeout time.Duration) Operator[A, A] {
 //	)
 //	value, err := result(parentCtx)()  // Will use parent's 1-hour deadline
 func WithDeadline[A any](deadline time.Time) Operator[A, A] {
-	return Local[A](func(ctx context.Context) (context.Context, context.CancelFunc) {
+	return localWithCause[A](func(ctx context.Context) (context.Context, context.CancelFunc) {
 		return context.WithDeadline(ctx, deadline)
 	})
 }
@@ -0,0 +1,395 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eq
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+type (
+	// DeriveOption customizes the Eq instance produced by [Derive] and [TryDerive].
+	DeriveOption func(*deriveConfig)
+
+	deriveConfig struct {
+		skip           map[string]bool
+		fieldEqs       map[string]fieldEq
+		epsilon        float64
+		nilEqualsEmpty bool
+	}
+
+	// fieldEq is a user supplied comparison for a single field
+	fieldEq struct {
+		typ    reflect.Type
+		equals comparator
+	}
+
+	// comparator compares two values of the same type, visited records the pointer pairs
+	// under comparison so that cyclic data terminates
+	comparator = func(x, y reflect.Value, visited map[visit]bool) bool
+
+	// visit identifies a pair of pointers of the same type under comparison
+	visit struct {
+		x, y uintptr
+		typ  reflect.Type
+	}
+)
+
+// SkipFields excludes the named fields from the comparison. Nested fields are
+// addressed by their path, e.g. "Address.Street".
+func SkipFields(names ...string) DeriveOption {
+	return func(cfg *deriveConfig) {
+		for _, name := range names {
+			cfg.skip[name] = true
+		}
+	}
+}
+
+// FloatEpsilon compares all float fields within the given absolute tolerance.
+func FloatEpsilon(epsilon float64) DeriveOption {
+	return func(cfg *deriveConfig) {
+		cfg.epsilon = epsilon
+	}
+}
+
+// NilEqualsEmpty treats nil and empty slices and maps as equal.
+func NilEqualsEmpty() DeriveOption {
+	return func(cfg *deriveConfig) {
+		cfg.nilEqualsEmpty = true
+	}
+}
+
+// FieldEq compares the named field using the given Eq instance instead of deriving one.
+// The type of the field must be F. Nested fields are addressed by their path, e.g. "Address.Street".
+func FieldEq[F any](name string, e Eq[F]) DeriveOption {
+	return func(cfg *deriveConfig) {
+		cfg.fieldEqs[name] = fieldEq{
+			typ: reflect.TypeFor[F](),
+			equals: func(x, y reflect.Value, _ map[visit]bool) bool {
+				return e.Equals(valueAs[F](x), valueAs[F](y))
+			},
+		}
+	}
+}
+
+// TryDerive derives an Eq instance for T by comparing its exported fields via reflection.
+//
+// Structs are compared field by field, pointers by the values they point to, and slices, arrays and maps
+// element by element. Unexported fields are ignored. Interface fields are compared with [reflect.DeepEqual].
+// Cyclic data is supported, like [reflect.DeepEqual] a pair of pointers that is revisited is considered equal.
+//
+// An error is returned if T contains functions, channels or unsafe pointers, or if an option refers to
+// a field that does not exist or has a different type.
+//
+// Example:
+//
+//	type Config struct {
+//	    Name    string
+//	    Ratio   float64
+//	    Tags    []string
+//	    Updated time.Time
+//	}
+//
+//	configEq, err := eq.TryDerive[Config](
+//	    eq.SkipFields("Updated"),
+//	    eq.FloatEpsilon(1e-9),
+//	    eq.NilEqualsEmpty(),
+//	)
+func TryDerive[T any](opts ...DeriveOption) (Eq[T], error) {
+	cfg := deriveConfig{
+		skip:     make(map[string]bool),
+		fieldEqs: make(map[string]fieldEq),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	b := deriveBuilder{
+		cfg:      &cfg,
+		building: make(map[reflect.Type]*comparator),
+		seen:     make(map[string]bool),
+	}
+	cmp, err := b.build(reflect.TypeFor[T](), "")
+	if err != nil {
+		return nil, err
+	}
+	if err := b.checkUnused(); err != nil {
+		return nil, err
+	}
+
+	return FromEquals(func(x, y T) bool {
+		return cmp(reflect.ValueOf(&x).Elem(), reflect.ValueOf(&y).Elem(), make(map[visit]bool))
+	}), nil
+}
+
+// Derive derives an Eq instance for T by comparing its exported fields via reflection, see [TryDerive].
+// It panics if the Eq instance cannot be derived, which makes it suitable for package level variables and tests.
+//
+// Example:
+//
+//	var configEq = eq.Derive[Config](eq.SkipFields("Updated"))
+func Derive[T any](opts ...DeriveOption) Eq[T] {
+	e, err := TryDerive[T](opts...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// deriveBuilder keeps the state while building the comparator for a type
+type deriveBuilder struct {
+	cfg *deriveConfig
+	// comparators of the types currently being built, used to support recursive types
+	building map[reflect.Type]*comparator
+	// field paths that have been visited
+	seen map[string]bool
+}
+
+func (b *deriveBuilder) build(t reflect.Type, path string) (comparator, error) {
+	if cmp, ok := b.building[t]; ok {
+		// recursive type, resolve the comparator lazily
+		return func(x, y reflect.Value, visited map[visit]bool) bool {
+			return (*cmp)(x, y, visited)
+		}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil, fmt.Errorf("eq: cannot derive Eq for %s of kind %s", describe(t, path), t.Kind())
+
+	case reflect.Float32, reflect.Float64:
+		if epsilon := b.cfg.epsilon; epsilon > 0 {
+			return func(x, y reflect.Value, visited map[visit]bool) bool {
+				a, c := x.Float(), y.Float()
+				return a == c || math.Abs(a-c) <= epsilon
+			}, nil
+		}
+		return func(x, y reflect.Value, visited map[visit]bool) bool {
+			return x.Float() == y.Float()
+		}, nil
+
+	case reflect.Interface:
+		return func(x, y reflect.Value, visited map[visit]bool) bool {
+			return reflect.DeepEqual(x.Interface(), y.Interface())
+		}, nil
+
+	case reflect.Pointer:
+		return b.recursive(t, func() (comparator, error) {
+			elem, err := b.build(t.Elem(), path)
+			if err != nil {
+				return nil, err
+			}
+			return func(x, y reflect.Value, visited map[visit]bool) bool {
+				if x.IsNil() || y.IsNil() {
+					return x.IsNil() == y.IsNil()
+				}
+				if x.Pointer() == y.Pointer() || isVisited(x, y, visited) {
+					return true
+				}
+				return elem(x.Elem(), y.Elem(), visited)
+			}, nil
+		})
+
+	case reflect.Slice:
+		return b.recursive(t, func() (comparator, error) {
+			elem, err := b.build(t.Elem(), path)
+			if err != nil {
+				return nil, err
+			}
+			return func(x, y reflect.Value, visited map[visit]bool) bool {
+				if !b.cfg.nilEqualsEmpty && x.IsNil() != y.IsNil() {
+					return false
+				}
+				if x.Len() != y.Len() {
+					return false
+				}
+				if isVisited(x, y, visited) {
+					return true
+				}
+				return sameElements(x, y, elem, visited)
+			}, nil
+		})
+
+	case reflect.Array:
+		elem, err := b.build(t.Elem(), path)
+		if err != nil {
+			return nil, err
+		}
+		return func(x, y reflect.Value, visited map[visit]bool) bool {
+			return sameElements(x, y, elem, visited)
+		}, nil
+
+	case reflect.Map:
+		return b.recursive(t, func() (comparator, error) {
+			if _, err := b.build(t.Key(), path); err != nil {
+				return nil, err
+			}
+			elem, err := b.build(t.Elem(), path)
+			if err != nil {
+				return nil, err
+			}
+			return func(x, y reflect.Value, visited map[visit]bool) bool {
+				if !b.cfg.nilEqualsEmpty && x.IsNil() != y.IsNil() {
+					return false
+				}
+				if x.Len() != y.Len() {
+					return false
+				}
+				if isVisited(x, y, visited) {
+					return true
+				}
+				iter := x.MapRange()
+				for iter.Next() {
+					other := y.MapIndex(iter.Key())
+					if !other.IsValid() || !elem(iter.Value(), other, visited) {
+						return false
+					}
+				}
+				return true
+			}, nil
+		})
+
+	case reflect.Struct:
+		return b.recursive(t, func() (comparator, error) {
+			return b.buildStruct(t, path)
+		})
+
+	default:
+		return func(x, y reflect.Value, visited map[visit]bool) bool {
+			return x.Equal(y)
+		}, nil
+	}
+}
+
+// recursive registers the comparator for t before building it, so that recursive references resolve to it
+func (b *deriveBuilder) recursive(t reflect.Type, f func() (comparator, error)) (comparator, error) {
+	var cmp comparator
+	b.building[t] = &cmp
+	defer delete(b.building, t)
+
+	c, err := f()
+	cmp = c
+	return c, err
+}
+
+func (b *deriveBuilder) buildStruct(t reflect.Type, path string) (comparator, error) {
+	type field struct {
+		index int
+		cmp   comparator
+	}
+	var fields []field
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fieldPath := sf.Name
+		if path != "" {
+			fieldPath = path + "." + sf.Name
+		}
+		b.seen[fieldPath] = true
+
+		if b.cfg.skip[fieldPath] {
+			continue
+		}
+		if fe, ok := b.cfg.fieldEqs[fieldPath]; ok {
+			if fe.typ != sf.Type {
+				return nil, fmt.Errorf("eq: field %s has type %s, the Eq instance expects %s", fieldPath, sf.Type, fe.typ)
+			}
+			fields = append(fields, field{i, fe.equals})
+			continue
+		}
+		cmp, err := b.build(sf.Type, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field{i, cmp})
+	}
+
+	return func(x, y reflect.Value, visited map[visit]bool) bool {
+		for _, f := range fields {
+			if !f.cmp(x.Field(f.index), y.Field(f.index), visited) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// checkUnused reports options that refer to fields that do not exist
+func (b *deriveBuilder) checkUnused() error {
+	var unknown []string
+	for name := range b.cfg.skip {
+		if !b.seen[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	for name := range b.cfg.fieldEqs {
+		if !b.seen[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return fmt.Errorf("eq: unknown fields %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// sameElements compares slices or arrays element by element
+func sameElements(x, y reflect.Value, elem comparator, visited map[visit]bool) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+	for i := range x.Len() {
+		if !elem(x.Index(i), y.Index(i), visited) {
+			return false
+		}
+	}
+	return true
+}
+
+// describe names the type or field for error messages
+func describe(t reflect.Type, path string) string {
+	if path == "" {
+		return fmt.Sprintf("type %s", t)
+	}
+	return fmt.Sprintf("field %s", path)
+}
+
+// isVisited reports if the pair of pointers, slices or maps is already under comparison and marks it otherwise.
+// Like [reflect.DeepEqual], a pair that is revisited is considered equal, the comparison of the first visit decides.
+func isVisited(x, y reflect.Value, visited map[visit]bool) bool {
+	if x.IsNil() || y.IsNil() {
+		return false
+	}
+	v := visit{x.Pointer(), y.Pointer(), x.Type()}
+	if visited[v] {
+		return true
+	}
+	visited[v] = true
+	return false
+}
+
+// valueAs converts a reflected value to F, a nil interface converts to the zero value of F
+func valueAs[F any](v reflect.Value) F {
+	f, _ := v.Interface().(F)
+	return f
+}
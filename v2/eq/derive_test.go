@@ -0,0 +1,222 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eq
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	deriveAddress struct {
+		Street string
+		City   string
+	}
+
+	deriveSettings struct {
+		Name    string
+		Ratio   float64
+		Tags    []string
+		Labels  map[string]string
+		Address *deriveAddress
+		Ports   [2]int
+		Updated int64
+		secret  string
+	}
+
+	deriveNode struct {
+		Value int
+		Next  *deriveNode
+	}
+)
+
+func sampleConfig() deriveSettings {
+	return deriveSettings{
+		Name:    "svc",
+		Ratio:   0.3,
+		Tags:    []string{"a", "b"},
+		Labels:  map[string]string{"env": "prod"},
+		Address: &deriveAddress{Street: "Main", City: "Berlin"},
+		Ports:   [2]int{80, 443},
+		Updated: 1,
+		secret:  "x",
+	}
+}
+
+func TestDerive(t *testing.T) {
+	e := Derive[deriveSettings]()
+
+	x, y := sampleConfig(), sampleConfig()
+	assert.True(t, e.Equals(x, y))
+
+	// unexported fields are ignored
+	y.secret = "y"
+	assert.True(t, e.Equals(x, y))
+
+	// pointers are compared by value
+	y.Address = &deriveAddress{Street: "Main", City: "Berlin"}
+	assert.True(t, e.Equals(x, y))
+
+	y.Address.City = "Paris"
+	assert.False(t, e.Equals(x, y))
+
+	y = sampleConfig()
+	y.Labels["env"] = "dev"
+	assert.False(t, e.Equals(x, y))
+
+	y = sampleConfig()
+	y.Ports[1] = 8443
+	assert.False(t, e.Equals(x, y))
+
+	y = sampleConfig()
+	y.Address = nil
+	assert.False(t, e.Equals(x, y))
+	assert.True(t, e.Equals(y, y))
+}
+
+func TestDeriveSkipFields(t *testing.T) {
+	x, y := sampleConfig(), sampleConfig()
+	y.Updated = 2
+	y.Address.Street = "Side"
+
+	assert.False(t, Derive[deriveSettings]().Equals(x, y))
+	assert.False(t, Derive[deriveSettings](SkipFields("Updated")).Equals(x, y))
+	assert.True(t, Derive[deriveSettings](SkipFields("Updated", "Address.Street")).Equals(x, y))
+}
+
+func TestDeriveFloatEpsilon(t *testing.T) {
+	x, y := sampleConfig(), sampleConfig()
+	tenth := 0.1
+	y.Ratio = tenth + 0.2
+
+	assert.False(t, Derive[deriveSettings]().Equals(x, y))
+	assert.True(t, Derive[deriveSettings](FloatEpsilon(1e-9)).Equals(x, y))
+
+	y.Ratio = 0.4
+	assert.False(t, Derive[deriveSettings](FloatEpsilon(1e-9)).Equals(x, y))
+}
+
+func TestDeriveNilEqualsEmpty(t *testing.T) {
+	x, y := sampleConfig(), sampleConfig()
+	x.Tags, y.Tags = nil, []string{}
+	x.Labels, y.Labels = nil, map[string]string{}
+
+	assert.False(t, Derive[deriveSettings]().Equals(x, y))
+	assert.True(t, Derive[deriveSettings](NilEqualsEmpty()).Equals(x, y))
+
+	y.Tags = []string{"a"}
+	assert.False(t, Derive[deriveSettings](NilEqualsEmpty()).Equals(x, y))
+}
+
+func TestDeriveFieldEq(t *testing.T) {
+	x, y := sampleConfig(), sampleConfig()
+	y.Name = "SVC"
+	y.Address.City = "BERLIN"
+
+	e := Derive[deriveSettings](
+		FieldEq("Name", FromEquals(strings.EqualFold)),
+		FieldEq("Address.City", FromEquals(strings.EqualFold)),
+	)
+	assert.True(t, e.Equals(x, y))
+
+	y.Name = "other"
+	assert.False(t, e.Equals(x, y))
+}
+
+func TestDeriveRecursive(t *testing.T) {
+	e := Derive[*deriveNode]()
+
+	list := func(values ...int) *deriveNode {
+		var head *deriveNode
+		for i := len(values) - 1; i >= 0; i-- {
+			head = &deriveNode{Value: values[i], Next: head}
+		}
+		return head
+	}
+
+	assert.True(t, e.Equals(list(1, 2, 3), list(1, 2, 3)))
+	assert.False(t, e.Equals(list(1, 2, 3), list(1, 2, 4)))
+	assert.False(t, e.Equals(list(1, 2), list(1, 2, 3)))
+}
+
+func TestDeriveRejects(t *testing.T) {
+	type withFunc struct {
+		Name   string
+		Action func()
+	}
+	type withChan struct {
+		Events []chan int
+	}
+
+	_, err := TryDerive[withFunc]()
+	assert.ErrorContains(t, err, "field Action of kind func")
+
+	_, err = TryDerive[withChan]()
+	assert.ErrorContains(t, err, "field Events of kind chan")
+
+	_, err = TryDerive[func()]()
+	assert.ErrorContains(t, err, "type func()")
+
+	assert.Panics(t, func() { Derive[withFunc]() })
+
+	// skipping the offending field makes the type derivable
+	e, err := TryDerive[withFunc](SkipFields("Action"))
+	assert.NoError(t, err)
+	assert.True(t, e.Equals(withFunc{Name: "a"}, withFunc{Name: "a", Action: func() {}}))
+}
+
+func TestDeriveRejectsInvalidOptions(t *testing.T) {
+	_, err := TryDerive[deriveSettings](SkipFields("Missing"))
+	assert.ErrorContains(t, err, "unknown fields Missing")
+
+	_, err = TryDerive[deriveSettings](FieldEq("Name", FromStrictEquals[int]()))
+	assert.ErrorContains(t, err, "field Name has type string")
+}
+
+func TestDeriveFieldEqNilInterface(t *testing.T) {
+	type outcome struct {
+		Err error
+	}
+
+	e := Derive[outcome](FieldEq("Err", FromEquals(func(x, y error) bool {
+		return (x == nil) == (y == nil)
+	})))
+
+	assert.True(t, e.Equals(outcome{}, outcome{}))
+	assert.False(t, e.Equals(outcome{}, outcome{Err: errors.New("boom")}))
+	assert.True(t, e.Equals(outcome{Err: errors.New("a")}, outcome{Err: errors.New("b")}))
+}
+
+func TestDeriveCyclic(t *testing.T) {
+	e := Derive[*deriveNode]()
+
+	cycle := func(values ...int) *deriveNode {
+		head := &deriveNode{Value: values[0]}
+		last := head
+		for _, v := range values[1:] {
+			last.Next = &deriveNode{Value: v}
+			last = last.Next
+		}
+		last.Next = head
+		return head
+	}
+
+	assert.True(t, e.Equals(cycle(1, 2, 3), cycle(1, 2, 3)))
+	assert.False(t, e.Equals(cycle(1, 2, 3), cycle(1, 2, 4)))
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"fmt"
+	"slices"
+
+	EQ "github.com/IBM/fp-go/v2/eq"
+)
+
+type (
+	// Mismatcher is implemented by the [EQ.Eq] instances of this package that are able to
+	// report where two values differ. Each mismatch is the path to a differing part of the
+	// values, e.g. "street.name" or "[2]".
+	Mismatcher[T any] interface {
+		// Mismatches returns the paths to the differing parts of x and y, it is empty if x and y are equal.
+		Mismatches(x, y T) []string
+	}
+
+	// FieldEq compares a single named field of a struct of type T, see [Field].
+	FieldEq[T any] struct {
+		// Name is the name of the field used in mismatch reports
+		Name string
+		// Eq compares the field of two structs
+		Eq EQ.Eq[T]
+
+		mismatches func(x, y T) []string
+	}
+
+	// StructEq is an [EQ.Eq] for a struct that is composed of the [FieldEq] of its fields.
+	// In addition to [EQ.Eq] it implements [Mismatcher] to report the fields that differ.
+	StructEq[T any] struct {
+		fields []FieldEq[T]
+	}
+
+	pointerEq[A any] struct {
+		eqa EQ.Eq[A]
+	}
+
+	sliceEq[A any] struct {
+		eqa EQ.Eq[A]
+	}
+
+	mapEq[K comparable, V any] struct {
+		eqk EQ.Eq[K]
+		eqv EQ.Eq[V]
+	}
+)
+
+// Mismatches returns the paths to the differing parts of x and y according to eq. If eq does not
+// implement [Mismatcher] the path is empty, so a single empty string indicates that the values differ.
+func Mismatches[T any](eq EQ.Eq[T], x, y T) []string {
+	if m, ok := eq.(Mismatcher[T]); ok {
+		return m.Mismatches(x, y)
+	}
+	if eq.Equals(x, y) {
+		return nil
+	}
+	return []string{""}
+}
+
+// prefix prepends the name to the paths reported for a nested mismatch
+func prefix(name string, paths []string) []string {
+	result := make([]string, len(paths))
+	for i, path := range paths {
+		switch {
+		case path == "":
+			result[i] = name
+		case path[0] == '[':
+			result[i] = name + path
+		default:
+			result[i] = name + "." + path
+		}
+	}
+	return result
+}
+
+// Field constructs a [FieldEq] for the field of T that is extracted by get and compared using eqa.
+//
+// Example:
+//
+//	streetEq := eqtesting.Struct(
+//	    eqtesting.Field("num", func(s Street) int { return s.num }, eq.FromStrictEquals[int]()),
+//	    eqtesting.Field("name", func(s Street) string { return s.name }, eq.FromStrictEquals[string]()),
+//	)
+func Field[T, A any](name string, get func(T) A, eqa EQ.Eq[A]) FieldEq[T] {
+	return FieldEq[T]{
+		Name: name,
+		Eq:   EQ.Contramap[A](get)(eqa),
+		mismatches: func(x, y T) []string {
+			return prefix(name, Mismatches(eqa, get(x), get(y)))
+		},
+	}
+}
+
+// Struct constructs an [EQ.Eq] for a struct from the [FieldEq] of its fields. Two structs are
+// equal if all fields are equal. The result implements [Mismatcher] and reports the names of the
+// differing fields, including the path into nested structs, slices and maps.
+func Struct[T any](fieldEqs ...FieldEq[T]) StructEq[T] {
+	return StructEq[T]{fields: fieldEqs}
+}
+
+// Equals implements [EQ.Eq]
+func (s StructEq[T]) Equals(x, y T) bool {
+	for _, f := range s.fields {
+		if !f.Eq.Equals(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// Mismatches implements [Mismatcher] and returns the paths to the fields that differ
+func (s StructEq[T]) Mismatches(x, y T) []string {
+	var result []string
+	for _, f := range s.fields {
+		if f.mismatches != nil {
+			result = append(result, f.mismatches(x, y)...)
+		} else if !f.Eq.Equals(x, y) {
+			result = append(result, f.Name)
+		}
+	}
+	return result
+}
+
+// PointerOf constructs an [EQ.Eq] for pointers that compares the values pointed to using eqa.
+// Two nil pointers are equal, a nil pointer is never equal to a non nil pointer.
+func PointerOf[A any](eqa EQ.Eq[A]) EQ.Eq[*A] {
+	return pointerEq[A]{eqa}
+}
+
+// Equals implements [EQ.Eq]
+func (p pointerEq[A]) Equals(x, y *A) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x == y || p.eqa.Equals(*x, *y)
+}
+
+// Mismatches implements [Mismatcher]
+func (p pointerEq[A]) Mismatches(x, y *A) []string {
+	if x == nil || y == nil {
+		if x == y {
+			return nil
+		}
+		return []string{""}
+	}
+	return Mismatches(p.eqa, *x, *y)
+}
+
+// SliceOf constructs an [EQ.Eq] for slices that compares the elements pairwise using eqa.
+// Slices of different lengths are never equal, a nil slice equals an empty slice.
+func SliceOf[A any](eqa EQ.Eq[A]) EQ.Eq[[]A] {
+	return sliceEq[A]{eqa}
+}
+
+// Equals implements [EQ.Eq]
+func (s sliceEq[A]) Equals(x, y []A) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if !s.eqa.Equals(x[i], y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Mismatches implements [Mismatcher] and reports the indexes of the differing elements
+func (s sliceEq[A]) Mismatches(x, y []A) []string {
+	if len(x) != len(y) {
+		return []string{fmt.Sprintf("len(%d != %d)", len(x), len(y))}
+	}
+	var result []string
+	for i := range x {
+		result = append(result, prefix(fmt.Sprintf("[%d]", i), Mismatches(s.eqa, x[i], y[i]))...)
+	}
+	return result
+}
+
+// MapOf constructs an [EQ.Eq] for maps. Two maps are equal if they have the same size and
+// every key of the first map has an equal key in the second map, according to eqk, whose value
+// is equal according to eqv.
+func MapOf[K comparable, V any](eqk EQ.Eq[K], eqv EQ.Eq[V]) EQ.Eq[map[K]V] {
+	return mapEq[K, V]{eqk, eqv}
+}
+
+// lookup returns the value of the key in m that is equal to k
+func (m mapEq[K, V]) lookup(k K, values map[K]V) (V, bool) {
+	if v, ok := values[k]; ok {
+		return v, true
+	}
+	for key, v := range values {
+		if m.eqk.Equals(k, key) {
+			return v, true
+		}
+	}
+	var v V
+	return v, false
+}
+
+// Equals implements [EQ.Eq]
+func (m mapEq[K, V]) Equals(x, y map[K]V) bool {
+	return len(m.Mismatches(x, y)) == 0
+}
+
+// Mismatches implements [Mismatcher] and reports the keys with differing or missing values in sorted order
+func (m mapEq[K, V]) Mismatches(x, y map[K]V) []string {
+	var result []string
+	for k, vx := range x {
+		path := fmt.Sprintf("[%v]", k)
+		vy, ok := m.lookup(k, y)
+		if !ok {
+			result = append(result, path)
+			continue
+		}
+		result = append(result, prefix(path, Mismatches(m.eqv, vx, vy))...)
+	}
+	if len(result) == 0 && len(x) != len(y) {
+		result = append(result, fmt.Sprintf("len(%d != %d)", len(x), len(y)))
+	}
+	slices.Sort(result)
+	return result
+}
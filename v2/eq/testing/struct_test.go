@@ -0,0 +1,122 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"testing"
+
+	EQ "github.com/IBM/fp-go/v2/eq"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	Street struct {
+		num  int
+		name string
+	}
+
+	Address struct {
+		city   string
+		street *Street
+		tags   []string
+		extra  map[string]int
+	}
+)
+
+var (
+	streetEq = Struct(
+		Field("num", func(s Street) int { return s.num }, EQ.FromStrictEquals[int]()),
+		Field("name", func(s Street) string { return s.name }, EQ.FromStrictEquals[string]()),
+	)
+
+	addressEq = Struct(
+		Field("city", func(a Address) string { return a.city }, EQ.FromStrictEquals[string]()),
+		Field("street", func(a Address) *Street { return a.street }, PointerOf[Street](streetEq)),
+		Field("tags", func(a Address) []string { return a.tags }, SliceOf(EQ.FromStrictEquals[string]())),
+		Field("extra", func(a Address) map[string]int { return a.extra }, MapOf(EQ.FromStrictEquals[string](), EQ.FromStrictEquals[int]())),
+	)
+
+	sampleAddress = Address{
+		city:   "Böblingen",
+		street: &Street{num: 220, name: "Schönaicherstr"},
+		tags:   []string{"office"},
+		extra:  map[string]int{"floor": 3},
+	}
+)
+
+func TestStructEq(t *testing.T) {
+	cpy := sampleAddress
+	cpy.street = &Street{num: 220, name: "Schönaicherstr"}
+
+	assert.True(t, addressEq.Equals(sampleAddress, cpy))
+	assert.Empty(t, addressEq.Mismatches(sampleAddress, cpy))
+
+	assert.True(t, streetEq.Equals(Street{1, "a"}, Street{1, "a"}))
+	assert.False(t, streetEq.Equals(Street{1, "a"}, Street{2, "a"}))
+}
+
+func TestStructEqMismatches(t *testing.T) {
+	other := sampleAddress
+	other.street = &Street{num: 220, name: "Neue Str"}
+	other.tags = []string{"home"}
+	other.extra = map[string]int{"floor": 4}
+
+	assert.False(t, addressEq.Equals(sampleAddress, other))
+	assert.Equal(t, []string{"street.name", "tags[0]", "extra[floor]"}, addressEq.Mismatches(sampleAddress, other))
+
+	other = sampleAddress
+	other.city = "Stuttgart"
+	other.street = nil
+	assert.Equal(t, []string{"city", "street"}, addressEq.Mismatches(sampleAddress, other))
+}
+
+func TestPointerOf(t *testing.T) {
+	eq := PointerOf(EQ.FromStrictEquals[int]())
+	a, b := 1, 1
+	c := 2
+
+	assert.True(t, eq.Equals(nil, nil))
+	assert.True(t, eq.Equals(&a, &b))
+	assert.False(t, eq.Equals(&a, &c))
+	assert.False(t, eq.Equals(&a, nil))
+	assert.False(t, eq.Equals(nil, &a))
+}
+
+func TestSliceOf(t *testing.T) {
+	eq := SliceOf(EQ.FromStrictEquals[int]())
+
+	assert.True(t, eq.Equals(nil, []int{}))
+	assert.True(t, eq.Equals([]int{1, 2}, []int{1, 2}))
+	assert.False(t, eq.Equals([]int{1, 2}, []int{2, 1}))
+	assert.Equal(t, []string{"len(2 != 1)"}, Mismatches(eq, []int{1, 2}, []int{1}))
+	assert.Equal(t, []string{"[0]", "[1]"}, Mismatches(eq, []int{1, 2}, []int{2, 1}))
+}
+
+func TestMapOf(t *testing.T) {
+	eq := MapOf(EQ.FromStrictEquals[string](), EQ.FromStrictEquals[int]())
+
+	assert.True(t, eq.Equals(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}))
+	assert.False(t, eq.Equals(map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}))
+	assert.Equal(t, []string{"[a]", "[b]"}, Mismatches(eq, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2, "c": 2}))
+	assert.Equal(t, []string{"len(1 != 2)"}, Mismatches(eq, map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}))
+}
+
+func TestMismatchesWithoutMismatcher(t *testing.T) {
+	eq := EQ.FromStrictEquals[int]()
+
+	assert.Empty(t, Mismatches(eq, 1, 1))
+	assert.Equal(t, []string{""}, Mismatches(eq, 1, 2))
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+
+	M "github.com/IBM/fp-go/v2/monoid"
+	O "github.com/IBM/fp-go/v2/option"
+	S "github.com/IBM/fp-go/v2/semigroup"
+)
+
+// Errors is a non-empty list of errors that implements the error interface. In contrast to
+// the result of [errors.Join] the individual errors can be accessed in a structured way,
+// e.g. to report each failure of a validation separately.
+//
+// An Errors value is created via [Of], [FromJoin] or by combining errors using [Semigroup],
+// [ErrorSemigroup] or [Monoid]. Like the result of [errors.Join] it is used as a pointer, so errors can
+// be compared safely. The zero value is not valid.
+type Errors struct {
+	errs []error
+}
+
+// Error implements the error interface. The messages of the individual errors are separated by newlines,
+// consistent with [errors.Join].
+func (e *Errors) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the individual errors, so [errors.Is] and [errors.As] match each of them
+func (e *Errors) Unwrap() []error {
+	return append([]error(nil), e.errs...)
+}
+
+// Of creates [Errors] from one or more errors
+//
+// Example:
+//
+//	errs := errors.Of(errNameMissing, errAgeInvalid)
+func Of(err error, errs ...error) *Errors {
+	return &Errors{errs: append([]error{err}, errs...)}
+}
+
+// joinedErrorType is the type of the error returned by [errors.Join]
+var joinedErrorType = reflect.TypeOf(errors.Join(errors.New("")))
+
+// IsJoin reports if err has been created by [errors.Join]
+func IsJoin(err error) bool {
+	return reflect.TypeOf(err) == joinedErrorType
+}
+
+// flatten returns the individual errors of [Errors] or of an error created by [errors.Join], or the error
+// itself. Other errors that wrap multiple errors, e.g. created by [fmt.Errorf] with several %w verbs, are
+// kept intact since their message carries additional context.
+func flatten(err error) []error {
+	switch e := err.(type) {
+	case *Errors:
+		return e.errs
+	}
+	if IsJoin(err) {
+		return err.(interface{ Unwrap() []error }).Unwrap()
+	}
+	return []error{err}
+}
+
+// FromJoin converts an error into [Errors]. The result of [errors.Join] is split into its individual
+// errors, any other error becomes a single entry. A nil error yields None.
+//
+// Example:
+//
+//	errs := errors.FromJoin(stderrors.Join(err1, err2)) // Some(Errors[err1, err2])
+func FromJoin(err error) O.Option[*Errors] {
+	if err == nil {
+		return O.None[*Errors]()
+	}
+	errs, ok := err.(*Errors)
+	if !ok {
+		errs = &Errors{errs: flatten(err)}
+	}
+	if len(errs.errs) == 0 {
+		return O.None[*Errors]()
+	}
+	return O.Some(errs)
+}
+
+// Len returns the number of individual errors, it is always at least one
+func Len(e *Errors) int {
+	return len(e.errs)
+}
+
+// MapEach returns a function that transforms each individual error, e.g. to add context
+//
+// Example:
+//
+//	withField := errors.MapEach(func(err error) error {
+//	    return fmt.Errorf("field name: %w", err)
+//	})
+func MapEach(f func(error) error) func(*Errors) *Errors {
+	return func(e *Errors) *Errors {
+		errs := make([]error, len(e.errs))
+		for i, err := range e.errs {
+			errs[i] = f(err)
+		}
+		return &Errors{errs: errs}
+	}
+}
+
+// Semigroup returns the [S.Semigroup] that concatenates [Errors]
+func Semigroup() S.Semigroup[*Errors] {
+	return S.MakeSemigroup(func(left, right *Errors) *Errors {
+		return &Errors{errs: slices.Concat(left.errs, right.errs)}
+	})
+}
+
+// concatErrors combines two errors into [Errors], nil errors are ignored
+func concatErrors(left, right error) error {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &Errors{errs: slices.Concat(flatten(left), flatten(right))}
+}
+
+// ErrorSemigroup returns a [S.Semigroup] for errors that combines them into [Errors]. [Errors] and the
+// results of [errors.Join] are flattened, so repeated concatenation yields a flat list.
+//
+// The semigroup can be used with the validating applicative of the result package, so callers
+// can access the individual failures of a validation via [FromJoin] or [errors.As].
+//
+// Example:
+//
+//	res := result.SequenceTV2[string, int](errors.ErrorSemigroup())(validateName(n), validateAge(a))
+func ErrorSemigroup() S.Semigroup[error] {
+	return S.MakeSemigroup(concatErrors)
+}
+
+// Monoid returns a [M.Monoid] for errors that combines them into [Errors], the empty value is nil
+func Monoid() M.Monoid[error] {
+	return M.MakeMonoid(concatErrors, nil)
+}
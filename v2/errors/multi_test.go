@@ -0,0 +1,139 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	EQ "github.com/IBM/fp-go/v2/eq"
+	O "github.com/IBM/fp-go/v2/option"
+	ST "github.com/IBM/fp-go/v2/semigroup/testing"
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+var (
+	err1 = errors.New("first")
+	err2 = errors.New("second")
+	err3 = errors.New("third")
+)
+
+func TestErrorsFormatting(t *testing.T) {
+	assert.EqualError(t, Of(err1), "first")
+	assert.EqualError(t, Of(err1, err2, err3), "first\nsecond\nthird")
+	assert.Equal(t, errors.Join(err1, err2).Error(), Of(err1, err2).Error())
+	assert.Equal(t, "first\nsecond", fmt.Sprintf("%v", Of(err1, err2)))
+}
+
+func TestErrorsUnwrap(t *testing.T) {
+	errs := Of(err1, &MyError{})
+	wrapped := fmt.Errorf("validation failed: %w", errs)
+
+	assert.ErrorIs(t, wrapped, err1)
+	assert.NotErrorIs(t, wrapped, err2)
+
+	var myErr *MyError
+	assert.ErrorAs(t, wrapped, &myErr)
+
+	var extracted *Errors
+	assert.ErrorAs(t, wrapped, &extracted)
+	assert.Equal(t, 2, Len(extracted))
+
+	// the returned slice does not expose the internal state
+	errs.Unwrap()[0] = err3
+	assert.Equal(t, []error{err1, &MyError{}}, errs.Unwrap())
+}
+
+func TestErrorsComparable(t *testing.T) {
+	errs := Of(err1, err2)
+	var err error = errs
+
+	assert.NotPanics(t, func() {
+		assert.True(t, err == errs)
+		assert.False(t, err == error(Of(err1, err2)))
+	})
+	assert.ErrorIs(t, fmt.Errorf("wrapped: %w", errs), errs)
+	assert.NotErrorIs(t, Of(err1), Of(err1))
+}
+
+func TestFromJoin(t *testing.T) {
+	assert.Equal(t, O.None[*Errors](), FromJoin(nil))
+	assert.Equal(t, O.Some(Of(err1)), FromJoin(err1))
+	assert.Equal(t, O.Some(Of(err1, err2)), FromJoin(errors.Join(err1, err2)))
+	assert.Equal(t, O.Some(Of(err1, err2)), FromJoin(Of(err1, err2)))
+}
+
+func TestMapEach(t *testing.T) {
+	withField := MapEach(func(err error) error {
+		return fmt.Errorf("name: %w", err)
+	})
+
+	errs := withField(Of(err1, err2))
+	assert.EqualError(t, errs, "name: first\nname: second")
+	assert.ErrorIs(t, errs, err1)
+	assert.ErrorIs(t, errs, err2)
+	assert.Equal(t, 2, Len(errs))
+}
+
+func TestSemigroupAssociativity(t *testing.T) {
+	sg := Semigroup()
+	a, b, c := Of(err1), Of(err2, err3), Of(err1)
+
+	assert.Equal(t, sg.Concat(sg.Concat(a, b), c), sg.Concat(a, sg.Concat(b, c)))
+	assert.Equal(t, Of(err1, err2, err3, err1), sg.Concat(sg.Concat(a, b), c))
+}
+
+func TestErrorSemigroupAndMonoid(t *testing.T) {
+	sg := ErrorSemigroup()
+
+	assert.Equal(t, sg.Concat(sg.Concat(err1, err2), err3), sg.Concat(err1, sg.Concat(err2, err3)))
+	assert.Equal(t, Of(err1, err2, err3), sg.Concat(errors.Join(err1, err2), err3))
+
+	m := Monoid()
+	assert.Nil(t, m.Empty())
+	assert.Equal(t, err1, m.Concat(m.Empty(), err1))
+	assert.Equal(t, err1, m.Concat(err1, m.Empty()))
+	assert.Equal(t, Of(err1, err2), m.Concat(err1, err2))
+}
+
+func TestErrorSemigroupReusesOperand(t *testing.T) {
+	sg := ErrorSemigroup()
+	errY, errZ := errors.New("y"), errors.New("z")
+
+	// concatenating onto the same value twice must not share state
+	x := sg.Concat(sg.Concat(err1, err2), err3)
+	withY := sg.Concat(x, errY)
+	withZ := sg.Concat(x, errZ)
+
+	assert.EqualError(t, withY, "first\nsecond\nthird\ny")
+	assert.EqualError(t, withZ, "first\nsecond\nthird\nz")
+	assert.EqualError(t, x, "first\nsecond\nthird")
+}
+
+func TestSemigroupLaws(t *testing.T) {
+	errorsEq := EQ.FromEquals(func(l, r *Errors) bool {
+		return slices.Equal(l.Unwrap(), r.Unwrap())
+	})
+	gen := rapid.Custom(func(rt *rapid.T) *Errors {
+		errs := rapid.SliceOfN(rapid.SampledFrom([]error{err1, err2, err3}), 1, 3).Draw(rt, "errs")
+		return Of(errs[0], errs[1:]...)
+	})
+
+	ST.CheckLaws(t, errorsEq, Semigroup(), gen)
+}
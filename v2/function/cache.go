@@ -281,3 +281,52 @@ func CacheCallback[
 func SingleElementCache[K comparable, T any]() func(K, func() func() T) func() T {
 	return G.SingleElementCache[func() func() T, K]()
 }
+
+// LRUCache creates a thread-safe cache implementation that stores at most n elements.
+// When the cache is full, the least recently used element is evicted. A capacity smaller
+// than one is treated as one.
+//
+// The cache is suitable for use with [CacheCallback], see [MemoizeLRU] for the common case.
+//
+// Example:
+//
+//	memoizer := CacheCallback[string, int, int](
+//	    Identity[int],
+//	    LRUCache[int, string](100),
+//	)
+func LRUCache[K comparable, T any](n int) func(K, func() func() T) func() T {
+	return G.LRUCache[func() func() T, K](n)
+}
+
+// MemoizeLRU converts a unary function into a memoized version that caches at most n results.
+// When the cache is full, the result of the least recently used input is evicted.
+//
+// Like [Memoize], concurrent first calls for the same input execute f only once, the
+// other callers wait for that result.
+//
+// Example:
+//
+//	resolve := MemoizeLRU(1000, func(host string) string {
+//	    return lookup(host)
+//	})
+func MemoizeLRU[K comparable, T any](n int, f func(K) T) func(K) T {
+	return CacheCallback[T](Identity[K], LRUCache[K, T](n))(f)
+}
+
+// MemoizeWithEq converts a unary function into a memoized version for inputs that are not comparable.
+// Inputs are grouped by the hash function and compared using the Equals method of eq, typically an
+// [github.com/IBM/fp-go/v2/eq.Eq]. Inputs that are equal must produce the same hash.
+//
+// Like [Memoize], the cache is thread-safe and unbounded and concurrent first calls for the same
+// input execute f only once.
+//
+// Example:
+//
+//	sum := MemoizeWithEq(
+//	    eq.FromEquals(slices.Equal[[]int]),
+//	    func(xs []int) uint64 { return uint64(len(xs)) },
+//	    func(xs []int) int { ... },
+//	)
+func MemoizeWithEq[K, T any](eq interface{ Equals(x, y K) bool }, hash func(K) uint64, f func(K) T) func(K) T {
+	return G.MemoizeWithEq(eq.Equals, hash, f)
+}
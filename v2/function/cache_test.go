@@ -615,3 +615,138 @@ func TestMemoizeIntegration(t *testing.T) {
 		assert.Equal(t, 2, callCount)
 	})
 }
+
+// TestMemoizeLRU tests the MemoizeLRU function
+func TestMemoizeLRU(t *testing.T) {
+	t.Run("caches up to n values", func(t *testing.T) {
+		var calls []int
+		memoized := MemoizeLRU(2, func(n int) int {
+			calls = append(calls, n)
+			return n * n
+		})
+
+		assert.Equal(t, 1, memoized(1))
+		assert.Equal(t, 4, memoized(2))
+		assert.Equal(t, 1, memoized(1))
+		assert.Equal(t, 4, memoized(2))
+		assert.Equal(t, []int{1, 2}, calls)
+	})
+
+	t.Run("evicts the least recently used value", func(t *testing.T) {
+		var calls []int
+		memoized := MemoizeLRU(2, func(n int) int {
+			calls = append(calls, n)
+			return n * n
+		})
+
+		memoized(1)
+		memoized(2)
+		// touch 1, so 2 becomes the least recently used value
+		memoized(1)
+		// evicts 2
+		memoized(3)
+		assert.Equal(t, []int{1, 2, 3}, calls)
+
+		// 1 and 3 are cached
+		memoized(1)
+		memoized(3)
+		assert.Equal(t, []int{1, 2, 3}, calls)
+
+		// 2 has been evicted, recomputing it evicts 1
+		memoized(2)
+		memoized(1)
+		assert.Equal(t, []int{1, 2, 3, 2, 1}, calls)
+	})
+
+	t.Run("treats a capacity smaller than one as one", func(t *testing.T) {
+		var count int
+		memoized := MemoizeLRU(0, func(n int) int {
+			count++
+			return n
+		})
+
+		memoized(1)
+		memoized(1)
+		memoized(2)
+		memoized(1)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("executes concurrent first calls only once", func(t *testing.T) {
+		var count atomic.Int32
+		memoized := MemoizeLRU(10, func(n int) int {
+			count.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return n * 2
+		})
+
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Equal(t, 10, memoized(5))
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), count.Load())
+	})
+}
+
+type sliceEq struct{}
+
+func (sliceEq) Equals(x, y []int) bool {
+	return fmt.Sprint(x) == fmt.Sprint(y)
+}
+
+// TestMemoizeWithEq tests the MemoizeWithEq function
+func TestMemoizeWithEq(t *testing.T) {
+	t.Run("caches values of non comparable keys", func(t *testing.T) {
+		var count int
+		// a poor hash to provoke collisions
+		hash := func(xs []int) uint64 { return uint64(len(xs)) }
+		sum := MemoizeWithEq(sliceEq{}, hash, func(xs []int) int {
+			count++
+			total := 0
+			for _, x := range xs {
+				total += x
+			}
+			return total
+		})
+
+		assert.Equal(t, 6, sum([]int{1, 2, 3}))
+		assert.Equal(t, 6, sum([]int{1, 2, 3}))
+		assert.Equal(t, 1, count)
+
+		// same hash, different key
+		assert.Equal(t, 7, sum([]int{1, 2, 4}))
+		assert.Equal(t, 2, count)
+
+		assert.Equal(t, 6, sum([]int{1, 2, 3}))
+		assert.Equal(t, 7, sum([]int{1, 2, 4}))
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("executes concurrent first calls only once", func(t *testing.T) {
+		var count atomic.Int32
+		hash := func(xs []int) uint64 { return uint64(len(xs)) }
+		memoized := MemoizeWithEq(sliceEq{}, hash, func(xs []int) int {
+			count.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return len(xs)
+		})
+
+		var wg sync.WaitGroup
+		for i := range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				memoized([]int{i % 2, 1})
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), count.Load())
+	})
+}
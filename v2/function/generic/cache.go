@@ -16,6 +16,7 @@
 package generic
 
 import (
+	"container/list"
 	"sync"
 
 	L "github.com/IBM/fp-go/v2/internal/lazy"
@@ -101,3 +102,75 @@ func CacheCallback[
 		}
 	}
 }
+
+// LRUCache is a cache that keeps at most n elements and evicts the least recently used element
+func LRUCache[
+	LLT ~func() LT, // generator of the generator
+	K comparable, // key into the cache
+	LT ~func() T, // generator of a value
+	T any, // the cached data type
+](n int) func(K, LLT) LT {
+	type entry struct {
+		key   K
+		value LT
+	}
+
+	n = max(n, 1)
+
+	var l sync.Mutex
+	order := list.New()
+	cache := make(map[K]*list.Element)
+
+	return func(k K, gen LLT) LT {
+		l.Lock()
+		defer l.Unlock()
+
+		if elem, ok := cache[k]; ok {
+			order.MoveToFront(elem)
+			return elem.Value.(entry).value
+		}
+		// evict the least recently used element
+		if order.Len() >= n {
+			oldest := order.Back()
+			order.Remove(oldest)
+			delete(cache, oldest.Value.(entry).key)
+		}
+		value := gen()
+		cache[k] = order.PushFront(entry{k, value})
+		return value
+	}
+}
+
+// MemoizeWithEq converts a unary function into a unary function that caches the value depending on the parameter.
+// Keys are grouped by their hash and compared using the equals function, so they do not need to be comparable.
+func MemoizeWithEq[F ~func(K) T, K, T any](equals func(K, K) bool, hash func(K) uint64, f F) F {
+	type entry struct {
+		key   K
+		value func() T
+	}
+
+	var l sync.Mutex
+	buckets := make(map[uint64][]entry)
+
+	getOrCreate := func(k K) func() T {
+		h := hash(k)
+		// only lock to access a lazy accessor to the value
+		l.Lock()
+		defer l.Unlock()
+		for _, e := range buckets[h] {
+			if equals(e.key, k) {
+				return e.value
+			}
+		}
+		value := L.Memoize(func() T {
+			return f(k)
+		})
+		buckets[h] = append(buckets[h], entry{k, value})
+		return value
+	}
+
+	return func(k K) T {
+		// compute the value outside of the lock
+		return getOrCreate(k)()
+	}
+}
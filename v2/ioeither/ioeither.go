@@ -284,6 +284,12 @@ func Fold[E, A, B any](onLeft func(E) IO[B], onRight io.Kleisli[A, B]) func(IOEi
 	return eithert.MatchE(io.MonadChain[Either[E, A], B], onLeft, onRight)
 }
 
+// Match converts an [IOEither] into an [IO] by applying pure handlers for both the error and success cases.
+// In contrast to [Fold] the handlers do not produce side effects.
+func Match[E, A, B any](onLeft func(E) B, onRight func(A) B) func(IOEither[E, A]) IO[B] {
+	return io.Map(either.Fold(onLeft, onRight))
+}
+
 // GetOrElse extracts the value from a successful [IOEither] or computes a default value from the error
 func GetOrElse[E, A any](onLeft func(E) IO[A]) func(IOEither[E, A]) IO[A] {
 	return eithert.GetOrElse(io.MonadChain[Either[E, A], A], io.MonadOf[A], onLeft)
@@ -643,6 +649,25 @@ func TapLeft[A, EA, EB, B any](f Kleisli[EB, EA, B]) Operator[EA, A, A] {
 	return ChainFirstLeft[A](f)
 }
 
+// ChainFirstLeftIOK runs the [IO] returned by the function on the error of a Left but returns the original Left.
+// Right values pass through unchanged and the function is not invoked.
+//
+// Since the function returns a plain [IO] it cannot alter the outcome, which makes it suitable for
+// observation hooks such as logging or metrics.
+func ChainFirstLeftIOK[A, EA, B any](f io.Kleisli[EA, B]) Operator[EA, A, A] {
+	return ChainFirstLeft[A](function.Flow2(
+		f,
+		FromIO[EA, B],
+	))
+}
+
+// TapLeftIOK is an alias for [ChainFirstLeftIOK], executing an [IO] side effect on the error while preserving the original Left
+//
+//go:inline
+func TapLeftIOK[A, EA, B any](f io.Kleisli[EA, B]) Operator[EA, A, A] {
+	return ChainFirstLeftIOK[A](f)
+}
+
 // OrElse recovers from a Left (error) by providing an alternative computation.
 // If the IOEither is Right, it returns the value unchanged.
 // If the IOEither is Left, it applies the provided function to the error value,
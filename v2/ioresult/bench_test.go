@@ -125,3 +125,68 @@ func BenchmarkChainSequence(b *testing.B) {
 		)
 	}
 }
+
+func BenchmarkIOResultChain10(b *testing.B) {
+	inc := func(x int) IOResult[int] { return Of(x + 1) }
+
+	b.Run("build and execute", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = F.Pipe10(
+				Of(0),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+				Chain(inc),
+			)()
+		}
+	})
+
+	b.Run("execute", func(b *testing.B) {
+		pipeline := F.Pipe10(
+			Of(0),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+			Chain(inc),
+		)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = pipeline()
+		}
+	})
+}
+
+func BenchmarkIOResultMap10(b *testing.B) {
+	inc := N.Add(1)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = F.Pipe10(
+			Of(0),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+			Map(inc),
+		)()
+	}
+}
@@ -16,8 +16,11 @@
 package ioresult
 
 import (
+	"errors"
+
 	"github.com/IBM/fp-go/v2/ioeither"
 	L "github.com/IBM/fp-go/v2/optics/lens"
+	"github.com/IBM/fp-go/v2/result"
 )
 
 // Do creates an empty context of type [S] to be used with the [Bind] operation.
@@ -155,6 +158,49 @@ func ApS[S1, S2, T any](
 	return ioeither.ApS(setter, fa)
 }
 
+// ApSPar attaches a value to a context like [ApS] but evaluates the computation of the value
+// concurrently with the computation of the context. The result is produced after both
+// computations have completed.
+//
+// Chaining several ApSPar operations evaluates all of the bound computations concurrently,
+// so the latency of the block is determined by the slowest computation rather than their sum.
+//
+// If both computations fail, the errors are combined using [errors.Join] with the error of the
+// context first. If only one fails its error is returned as is.
+//
+// Example:
+//
+//	res := F.Pipe2(
+//	    ioresult.Do(State{}),
+//	    ioresult.ApSPar(setUser, fetchUser(id)),
+//	    ioresult.ApSPar(setOrg, fetchOrg(id)),
+//	) // fetchUser and fetchOrg run concurrently
+func ApSPar[S1, S2, T any](
+	setter func(T) func(S1) S2,
+	fa IOResult[T],
+) Operator[S1, S2] {
+	return func(ms IOResult[S1]) IOResult[S2] {
+		return func() Result[S2] {
+			c := make(chan Result[T], 1)
+			go func() {
+				c <- fa()
+				close(c)
+			}()
+			s1, errS := result.Unwrap(ms())
+			t, errT := result.Unwrap(<-c)
+			switch {
+			case errS != nil && errT != nil:
+				return result.Left[S2](errors.Join(errS, errT))
+			case errS != nil:
+				return result.Left[S2](errS)
+			case errT != nil:
+				return result.Left[S2](errT)
+			}
+			return result.Of(setter(t)(s1))
+		}
+	}
+}
+
 // ApSL attaches a value to a context using a lens-based setter.
 // This is a convenience function that combines ApS with a lens, allowing you to use
 // optics to update nested structures in a more composable way.
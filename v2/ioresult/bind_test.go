@@ -16,7 +16,9 @@
 package ioresult
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	F "github.com/IBM/fp-go/v2/function"
 	"github.com/IBM/fp-go/v2/internal/utils"
@@ -55,3 +57,65 @@ func TestApS(t *testing.T) {
 
 	assert.Equal(t, res(), result.Of("John Doe"))
 }
+
+// rendezvous returns an IOResult that signals its start and then waits for the other side to start,
+// so it only completes if both sides run concurrently
+func rendezvous[A any](started chan<- struct{}, other <-chan struct{}, res Result[A]) IOResult[A] {
+	return func() Result[A] {
+		close(started)
+		select {
+		case <-other:
+			return res
+		case <-time.After(5 * time.Second):
+			return result.Left[A](errors.New("the other side did not start"))
+		}
+	}
+}
+
+func TestApSPar(t *testing.T) {
+
+	res := F.Pipe3(
+		Do(utils.Empty),
+		ApSPar(utils.SetLastName, Of("Doe")),
+		ApSPar(utils.SetGivenName, Of("John")),
+		Map(utils.GetFullName),
+	)
+
+	assert.Equal(t, res(), result.Of("John Doe"))
+}
+
+func TestApSParConcurrent(t *testing.T) {
+	lastStarted := make(chan struct{})
+	givenStarted := make(chan struct{})
+
+	res := F.Pipe3(
+		Do(utils.Empty),
+		ApSPar(utils.SetLastName, rendezvous(lastStarted, givenStarted, result.Of("Doe"))),
+		ApSPar(utils.SetGivenName, rendezvous(givenStarted, lastStarted, result.Of("John"))),
+		Map(utils.GetFullName),
+	)
+
+	assert.Equal(t, result.Of("John Doe"), res())
+}
+
+func TestApSParErrors(t *testing.T) {
+	errLast := errors.New("no last name")
+	errGiven := errors.New("no given name")
+
+	bind := func(last, given IOResult[string]) Result[string] {
+		return F.Pipe3(
+			Do(utils.Empty),
+			ApSPar(utils.SetLastName, last),
+			ApSPar(utils.SetGivenName, given),
+			Map(utils.GetFullName),
+		)()
+	}
+
+	assert.Equal(t, result.Left[string](errLast), bind(Left[string](errLast), Of("John")))
+	assert.Equal(t, result.Left[string](errGiven), bind(Of("Doe"), Left[string](errGiven)))
+
+	_, err := result.Unwrap(bind(Left[string](errLast), Left[string](errGiven)))
+	assert.ErrorIs(t, err, errLast)
+	assert.ErrorIs(t, err, errGiven)
+	assert.Equal(t, "no last name\nno given name", err.Error())
+}
@@ -1,7 +1,7 @@
 // Copyright (c) 2023 - 2025 IBM Corp.
 // All rights reserved.
 //
-// Licensed under the Apache LicensVersion 2.0 (the "License");
+// Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
@@ -16,17 +16,61 @@
 package ioresult
 
 import (
-	"github.com/IBM/fp-go/v2/ioeither"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/fp-go/v2/result"
 )
 
 // Bracket makes sure that a resource is cleaned up in the event of an error. The release action is called regardless of
 // whether the body action returns and error or not.
 //
-//go:inline
+// The semantics are:
+//   - if acquire fails, neither use nor release are invoked and the acquire error is returned
+//   - release always runs after a successful acquire and receives the outcome of use
+//   - if release fails, its error is joined with the error of use (if any) using [errors.Join]
+//   - if use panics, release is invoked with a Left describing the panic and the panic is propagated
+//
+// Example:
+//
+//	content := ioresult.Bracket(
+//	    ioresult.Eitherize1(os.Open)("data.txt"),
+//	    func(f *os.File) ioresult.IOResult[[]byte] {
+//	        return ioresult.Eitherize1(io.ReadAll)(f)
+//	    },
+//	    func(f *os.File, _ result.Result[[]byte]) ioresult.IOResult[any] {
+//	        return ioresult.Eitherize0(func() (any, error) { return nil, f.Close() })()
+//	    },
+//	)
 func Bracket[A, B, ANY any](
 	acquire IOResult[A],
 	use Kleisli[A, B],
 	release func(A, Result[B]) IOResult[ANY],
 ) IOResult[B] {
-	return ioeither.Bracket(acquire, use, release)
+	return func() Result[B] {
+		a, err := result.Unwrap(acquire())
+		if err != nil {
+			return result.Left[B](err)
+		}
+		rb, recovered, panicked := tryUse(use, a)
+		if panicked {
+			// release the resource exactly once, then propagate the panic of use
+			release(a, result.Left[B](fmt.Errorf("panic during use of resource: %v", recovered)))()
+			panic(recovered)
+		}
+		if releaseErr := result.ToError(release(a, rb)()); releaseErr != nil {
+			return result.Left[B](errors.Join(result.ToError(rb), releaseErr))
+		}
+		return rb
+	}
+}
+
+// tryUse executes use and recovers from a panic, so that only panics of use are attributed to it
+func tryUse[A, B any](use Kleisli[A, B], a A) (rb Result[B], recovered any, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			recovered, panicked = r, true
+		}
+	}()
+	return use(a)(), nil, false
 }
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// resource records the lifecycle of a bracketed resource
+type resource struct {
+	released bool
+	outcome  Result[string]
+}
+
+func releaseWith(err error) func(*resource, Result[string]) IOResult[any] {
+	return func(r *resource, outcome Result[string]) IOResult[any] {
+		return func() Result[any] {
+			r.released = true
+			r.outcome = outcome
+			if err != nil {
+				return result.Left[any](err)
+			}
+			return result.Of[any](nil)
+		}
+	}
+}
+
+func TestBracketSuccess(t *testing.T) {
+	res := &resource{}
+	use := func(r *resource) IOResult[string] {
+		return Of("used")
+	}
+
+	assert.Equal(t, result.Of("used"), Bracket(Of(res), use, releaseWith(nil))())
+	assert.True(t, res.released)
+	assert.Equal(t, result.Of("used"), res.outcome)
+}
+
+func TestBracketAcquireFailure(t *testing.T) {
+	errAcquire := errors.New("acquire")
+	used := false
+	use := func(r *resource) IOResult[string] {
+		used = true
+		return Of("used")
+	}
+
+	assert.Equal(t, result.Left[string](errAcquire), Bracket(Left[*resource](errAcquire), use, releaseWith(nil))())
+	assert.False(t, used)
+}
+
+func TestBracketUseFailure(t *testing.T) {
+	errUse := errors.New("use")
+	res := &resource{}
+	use := func(r *resource) IOResult[string] {
+		return Left[string](errUse)
+	}
+
+	assert.Equal(t, result.Left[string](errUse), Bracket(Of(res), use, releaseWith(nil))())
+	assert.True(t, res.released)
+	assert.Equal(t, result.Left[string](errUse), res.outcome)
+}
+
+func TestBracketReleaseFailure(t *testing.T) {
+	errRelease := errors.New("release")
+	res := &resource{}
+	use := func(r *resource) IOResult[string] {
+		return Of("used")
+	}
+
+	err := result.ToError(Bracket(Of(res), use, releaseWith(errRelease))())
+	assert.ErrorIs(t, err, errRelease)
+	assert.Equal(t, "release", err.Error())
+	assert.True(t, res.released)
+}
+
+func TestBracketDoubleFailure(t *testing.T) {
+	errUse := errors.New("use")
+	errRelease := errors.New("release")
+	res := &resource{}
+	use := func(r *resource) IOResult[string] {
+		return Left[string](errUse)
+	}
+
+	err := result.ToError(Bracket(Of(res), use, releaseWith(errRelease))())
+	assert.ErrorIs(t, err, errUse)
+	assert.ErrorIs(t, err, errRelease)
+	assert.Equal(t, "use\nrelease", err.Error())
+}
+
+func TestBracketPanic(t *testing.T) {
+	res := &resource{}
+	use := func(r *resource) IOResult[string] {
+		return func() Result[string] {
+			panic("boom")
+		}
+	}
+
+	assert.PanicsWithValue(t, "boom", func() {
+		Bracket(Of(res), use, releaseWith(nil))()
+	})
+	assert.True(t, res.released)
+	assert.True(t, result.IsLeft(res.outcome))
+}
+
+func TestBracketReleasePanic(t *testing.T) {
+	calls := 0
+	release := func(*resource, Result[string]) IOResult[any] {
+		return func() Result[any] {
+			calls++
+			panic("release")
+		}
+	}
+
+	assert.PanicsWithValue(t, "release", func() {
+		Bracket(Of(&resource{}), func(*resource) IOResult[string] { return Of("data") }, release)()
+	})
+	assert.Equal(t, 1, calls)
+}
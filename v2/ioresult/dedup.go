@@ -0,0 +1,83 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/IBM/fp-go/v2/either"
+)
+
+// errDeduplicatePanic is reported to the waiters of a deduplicated computation that panicked
+var errDeduplicatePanic = errors.New("deduplicated computation panicked")
+
+// inflight tracks a single execution of a deduplicated computation
+type inflight[A any] struct {
+	done chan struct{}
+	res  Result[A]
+}
+
+// Deduplicate ensures that concurrent executions for the same key share a single in-flight execution,
+// similar to golang.org/x/sync/singleflight.
+//
+// Callers that execute the computation for a key while an execution for that key is in flight wait for it
+// and receive its result, including a Left. Once the execution completed, the next call executes the
+// computation again, i.e. results are not cached. Use [Memoize] or [MemoizeOnSuccess] for caching.
+//
+// Example:
+//
+//	token := ioresult.Deduplicate(func(audience string) IOResult[string] {
+//	    return fetchToken(audience)
+//	})
+//	// concurrent calls to token("api")() trigger a single fetch
+func Deduplicate[K comparable, A any](keyed Kleisli[K, A]) Kleisli[K, A] {
+	return deduplicate(keyed, func(K) {})
+}
+
+// deduplicate implements [Deduplicate], onJoin is invoked whenever a caller joins an in-flight execution
+func deduplicate[K comparable, A any](keyed Kleisli[K, A], onJoin func(K)) Kleisli[K, A] {
+	var mu sync.Mutex
+	calls := make(map[K]*inflight[A])
+
+	return func(k K) IOResult[A] {
+		return func() Result[A] {
+			mu.Lock()
+			if c, ok := calls[k]; ok {
+				mu.Unlock()
+				onJoin(k)
+				<-c.done
+				return c.res
+			}
+			c := &inflight[A]{
+				done: make(chan struct{}),
+				res:  either.Left[A](errDeduplicatePanic),
+			}
+			calls[k] = c
+			mu.Unlock()
+
+			defer func() {
+				mu.Lock()
+				delete(calls, k)
+				mu.Unlock()
+				close(c.done)
+			}()
+
+			c.res = keyed(k)()
+			return c.res
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// joinedTo reports the keys of callers that joined an in-flight execution
+func joinedTo(joins chan<- string) func(string) {
+	return func(key string) {
+		joins <- key
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	const callers = 10
+
+	// blocking returns a computation that counts its executions and waits for release
+	blocking := func(executions *atomic.Int32, started chan<- struct{}, release <-chan struct{}, res Result[string]) Kleisli[string, string] {
+		return func(key string) IOResult[string] {
+			return func() Result[string] {
+				executions.Add(1)
+				started <- struct{}{}
+				<-release
+				return res
+			}
+		}
+	}
+
+	// runConcurrently starts the callers once the first execution is in flight and releases it
+	// after all other callers joined it
+	runConcurrently := func(io IOResult[string], started <-chan struct{}, joins <-chan string, release chan<- struct{}) []Result[string] {
+		results := make([]Result[string], callers)
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[0] = io()
+		}()
+		<-started
+		for i := 1; i < callers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = io()
+			}()
+		}
+		for i := 1; i < callers; i++ {
+			<-joins
+		}
+		close(release)
+		wg.Wait()
+		return results
+	}
+
+	t.Run("shares one execution per in-flight window", func(t *testing.T) {
+		var executions atomic.Int32
+		started := make(chan struct{}, callers)
+		release := make(chan struct{})
+		joins := make(chan string, callers)
+		dedup := deduplicate(blocking(&executions, started, release, result.Of("token")), joinedTo(joins))
+
+		results := runConcurrently(dedup("api"), started, joins, release)
+
+		assert.Equal(t, int32(1), executions.Load())
+		for _, res := range results {
+			assert.Equal(t, result.Of("token"), res)
+		}
+	})
+
+	t.Run("propagates failures to all waiters", func(t *testing.T) {
+		errFetch := errors.New("fetch failed")
+		var executions atomic.Int32
+		started := make(chan struct{}, callers)
+		release := make(chan struct{})
+		joins := make(chan string, callers)
+		dedup := deduplicate(blocking(&executions, started, release, result.Left[string](errFetch)), joinedTo(joins))
+
+		results := runConcurrently(dedup("api"), started, joins, release)
+
+		assert.Equal(t, int32(1), executions.Load())
+		for _, res := range results {
+			_, err := result.Unwrap(res)
+			assert.Equal(t, errFetch, err)
+		}
+	})
+
+	t.Run("executes again after completion", func(t *testing.T) {
+		var executions atomic.Int32
+		dedup := Deduplicate(func(key string) IOResult[string] {
+			return func() Result[string] {
+				executions.Add(1)
+				return result.Of(key)
+			}
+		})
+
+		assert.Equal(t, result.Of("a"), dedup("a")())
+		assert.Equal(t, result.Of("a"), dedup("a")())
+		assert.Equal(t, result.Of("b"), dedup("b")())
+		assert.Equal(t, int32(3), executions.Load())
+	})
+
+	t.Run("different keys do not share executions", func(t *testing.T) {
+		var executions atomic.Int32
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		dedup := Deduplicate(blocking(&executions, started, release, result.Of("token")))
+
+		var wg sync.WaitGroup
+		for _, key := range []string{"a", "b"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				dedup(key)()
+			}()
+		}
+		<-started
+		<-started
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(2), executions.Load())
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache LicensVersion 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/ioresult"
+	"github.com/IBM/fp-go/v2/result"
+)
+
+// defaultPerm is the permission used for files written by [WriteJSON]
+const defaultPerm os.FileMode = 0o644
+
+// position converts the offset reported by the JSON decoder into the line and column of the
+// last byte read, both starting at 1
+func position(data []byte, offset int64) (line, column int) {
+	before := data[:min(max(offset, 0), int64(len(data)))]
+	line = bytes.Count(before, []byte{'\n'}) + 1
+	column = max(len(before)-bytes.LastIndexByte(before, '\n')-1, 1)
+	return line, column
+}
+
+// decodeJSON decodes the data into a value of type A, errors report the path and, if known,
+// the position of the failure
+func decodeJSON[A any](path string) result.Kleisli[[]byte, A] {
+	return func(data []byte) Result[A] {
+		var a A
+		err := json.Unmarshal(data, &a)
+		if err == nil {
+			return result.Of(a)
+		}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line, column := position(data, syntaxErr.Offset)
+			return result.Left[A](fmt.Errorf("failed to decode JSON from %s at line %d, column %d: %w", path, line, column, err))
+		}
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			line, column := position(data, typeErr.Offset)
+			return result.Left[A](fmt.Errorf("failed to decode JSON from %s at line %d, column %d: %w", path, line, column, err))
+		}
+		return result.Left[A](fmt.Errorf("failed to decode JSON from %s: %w", path, err))
+	}
+}
+
+// writeEncoded encodes a value and writes the result to the path
+func writeEncoded[A any](path, format string, encode func(A) ([]byte, error)) Kleisli[A, Void] {
+	return func(a A) IOResult[Void] {
+		return func() Result[Void] {
+			data, err := encode(a)
+			if err != nil {
+				return result.Left[Void](fmt.Errorf("failed to encode %s for %s: %w", format, path, err))
+			}
+			if err := os.WriteFile(path, data, defaultPerm); err != nil {
+				return result.Left[Void](err)
+			}
+			return result.Of(F.VOID)
+		}
+	}
+}
+
+// ReadJSON reads the file at path and decodes its JSON content into a value of type A.
+//
+// Errors report the path of the file. Decode failures additionally report the line and
+// column of the failure if the JSON decoder provides an offset.
+//
+// Example:
+//
+//	type Config struct {
+//	    Port int `json:"port"`
+//	}
+//
+//	cfg := file.ReadJSON[Config]("config.json")
+func ReadJSON[A any](path string) IOResult[A] {
+	return F.Pipe1(
+		ReadFile(path),
+		ioresult.ChainResultK(decodeJSON[A](path)),
+	)
+}
+
+// WriteJSON returns a function that encodes a value as JSON and writes it to the file at path.
+// The file is created if it does not exist and truncated otherwise. If pretty is true the JSON
+// is indented by two spaces.
+//
+// Example:
+//
+//	save := file.WriteJSON[Config]("config.json", true)
+//	res := save(cfg)()
+func WriteJSON[A any](path string, pretty bool) Kleisli[A, Void] {
+	encode := func(a A) ([]byte, error) {
+		return json.Marshal(a)
+	}
+	if pretty {
+		encode = func(a A) ([]byte, error) {
+			return json.MarshalIndent(a, "", "  ")
+		}
+	}
+	return writeEncoded(path, "JSON", encode)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache LicensVersion 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct {
+	Name  string   `json:"name"`
+	Port  int      `json:"port"`
+	Hosts []string `json:"hosts"`
+}
+
+var sampleConfig = testConfig{Name: "service", Port: 8080, Hosts: []string{"a", "b"}}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, pretty := range []bool{false, true} {
+		path := filepath.Join(t.TempDir(), "config.json")
+
+		res := WriteJSON[testConfig](path, pretty)(sampleConfig)()
+		require.True(t, result.IsRight(res))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, pretty, len(data) > 0 && data[1] == '\n')
+
+		assert.Equal(t, result.Of(sampleConfig), ReadJSON[testConfig](path)())
+	}
+}
+
+func TestReadJSONMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.json")
+
+	_, err := result.Unwrap(ReadJSON[testConfig](path)())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.ErrorContains(t, err, path)
+}
+
+func TestReadJSONMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte("{\n  \"name\": \"service\",\n  \"port\": 80,,\n}"), 0o644))
+
+	_, err := result.Unwrap(ReadJSON[testConfig](path)())
+	assert.ErrorContains(t, err, path)
+	assert.ErrorContains(t, err, "line 3, column 14")
+}
+
+func TestReadJSONWrongType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte("{\n  \"port\": \"80\"\n}"), 0o644))
+
+	_, err := result.Unwrap(ReadJSON[testConfig](path)())
+	assert.ErrorContains(t, err, path)
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestWriteJSONInvalidPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "config.json")
+
+	_, err := result.Unwrap(WriteJSON[testConfig](path, false)(sampleConfig)())
+	assert.ErrorContains(t, err, path)
+}
+
+func TestWriteJSONEncodeError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	_, err := result.Unwrap(WriteJSON[func()](path, false)(func() {})())
+	assert.ErrorContains(t, err, path)
+	assert.NoFileExists(t, path)
+}
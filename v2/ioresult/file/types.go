@@ -15,7 +15,10 @@
 
 package file
 
-import "github.com/IBM/fp-go/v2/ioresult"
+import (
+	"github.com/IBM/fp-go/v2/ioresult"
+	"github.com/IBM/fp-go/v2/result"
+)
 
 type (
 	// IOResult represents a synchronous computation that may fail, returning a Result type.
@@ -85,4 +88,10 @@ type (
 	//       validateJSON,
 	//   )
 	Operator[A, B any] = ioresult.Operator[A, B]
+
+	// Result represents a computation that either succeeded with a value of type T or failed with an error.
+	Result[T any] = result.Result[T]
+
+	// Void represents the unit type, used as the result of operations that produce no value.
+	Void = ioresult.Void
 )
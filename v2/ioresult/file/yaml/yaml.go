@@ -0,0 +1,83 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package yaml reads and writes YAML files as [file.IOResult] values. It lives in its own package so
+// that only its users depend on the YAML library.
+package yaml
+
+import (
+	"fmt"
+	"os"
+
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/ioresult"
+	"github.com/IBM/fp-go/v2/ioresult/file"
+	"github.com/IBM/fp-go/v2/result"
+	Y "gopkg.in/yaml.v3"
+)
+
+// defaultPerm is the permission used for files written by [Write]
+const defaultPerm os.FileMode = 0o644
+
+// decode decodes the data into a value of type A, errors report the path, the YAML
+// errors carry the line of the failure
+func decode[A any](path string) result.Kleisli[[]byte, A] {
+	return func(data []byte) file.Result[A] {
+		var a A
+		if err := Y.Unmarshal(data, &a); err != nil {
+			return result.Left[A](fmt.Errorf("failed to decode YAML from %s: %w", path, err))
+		}
+		return result.Of(a)
+	}
+}
+
+// Read reads the file at path and decodes its YAML content into a value of type A.
+// Errors report the path of the file, decode failures include the line reported by the YAML decoder.
+//
+// Example:
+//
+//	type Config struct {
+//	    Port int `yaml:"port"`
+//	}
+//
+//	cfg := yaml.Read[Config]("config.yaml")
+func Read[A any](path string) file.IOResult[A] {
+	return F.Pipe1(
+		file.ReadFile(path),
+		ioresult.ChainResultK(decode[A](path)),
+	)
+}
+
+// Write returns a function that encodes a value as YAML and writes it to the file at path.
+// The file is created if it does not exist and truncated otherwise.
+//
+// Example:
+//
+//	save := yaml.Write[Config]("config.yaml")
+//	res := save(cfg)()
+func Write[A any](path string) file.Kleisli[A, file.Void] {
+	return func(a A) file.IOResult[file.Void] {
+		return func() file.Result[file.Void] {
+			data, err := Y.Marshal(a)
+			if err != nil {
+				return result.Left[file.Void](fmt.Errorf("failed to encode YAML for %s: %w", path, err))
+			}
+			if err := os.WriteFile(path, data, defaultPerm); err != nil {
+				return result.Left[file.Void](err)
+			}
+			return result.Of(F.VOID)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct {
+	Name  string   `yaml:"name"`
+	Port  int      `yaml:"port"`
+	Hosts []string `yaml:"hosts"`
+}
+
+var sampleConfig = testConfig{Name: "service", Port: 8080, Hosts: []string{"a", "b"}}
+
+func TestRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	res := Write[testConfig](path)(sampleConfig)()
+	require.True(t, result.IsRight(res))
+
+	assert.Equal(t, result.Of(sampleConfig), Read[testConfig](path)())
+}
+
+func TestReadMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("name: service\nport: [80\n"), 0o644))
+
+	_, err := result.Unwrap(Read[testConfig](path)())
+	assert.ErrorContains(t, err, path)
+	assert.ErrorContains(t, err, "line")
+
+	_, err = result.Unwrap(Read[testConfig](filepath.Join(t.TempDir(), "missing.yaml"))())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
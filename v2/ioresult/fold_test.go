@@ -0,0 +1,89 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/io"
+	"github.com/stretchr/testify/assert"
+)
+
+var errFold = errors.New("fold failed")
+
+// countingOf returns an IOResult that counts its executions
+func countingOf[A any](count *int, res IOResult[A]) IOResult[A] {
+	return func() Result[A] {
+		*count++
+		return res()
+	}
+}
+
+func TestFold(t *testing.T) {
+	fold := Fold(
+		func(err error) IO[string] { return io.Of("error: " + err.Error()) },
+		func(n int) IO[string] { return io.Of(strconv.Itoa(n)) },
+	)
+
+	assert.Equal(t, "42", fold(Of(42))())
+	assert.Equal(t, "error: fold failed", fold(Left[int](errFold))())
+}
+
+func TestMatch(t *testing.T) {
+	match := Match(
+		func(err error) string { return "error: " + err.Error() },
+		strconv.Itoa,
+	)
+
+	assert.Equal(t, "42", match(Of(42))())
+	assert.Equal(t, "error: fold failed", match(Left[int](errFold))())
+}
+
+func TestGetOrElse(t *testing.T) {
+	getOrElse := GetOrElse(func(error) IO[int] { return io.Of(-1) })
+	getOrElseOf := GetOrElseOf(func(error) int { return -1 })
+
+	assert.Equal(t, 42, getOrElse(Of(42))())
+	assert.Equal(t, -1, getOrElse(Left[int](errFold))())
+	assert.Equal(t, 42, getOrElseOf(Of(42))())
+	assert.Equal(t, -1, getOrElseOf(Left[int](errFold))())
+}
+
+func TestFoldIsLazy(t *testing.T) {
+	count := 0
+	src := countingOf(&count, Of(42))
+
+	folded := Fold(
+		func(error) IO[int] { return io.Of(0) },
+		io.Of[int],
+	)(src)
+	matched := Match(func(error) int { return 0 }, func(n int) int { return n })(src)
+	orElse := GetOrElseOf(func(error) int { return 0 })(src)
+
+	// nothing executed so far
+	assert.Equal(t, 0, count)
+
+	assert.Equal(t, 42, folded())
+	assert.Equal(t, 42, matched())
+	assert.Equal(t, 42, orElse())
+	assert.Equal(t, 3, count)
+
+	// every invocation executes the source again
+	assert.Equal(t, 42, matched())
+	assert.Equal(t, 4, count)
+}
@@ -134,14 +134,25 @@ func FromLazy[A any](mr Lazy[A]) IOResult[A] {
 	return ioeither.FromLazy[error](mr)
 }
 
-//go:inline
+// MonadMap transforms the value of a successful [IOResult].
+//
+// The implementation is fused into a single closure rather than composed from the generic
+// building blocks, because Map and Chain dominate the cost of long pipelines.
 func MonadMap[A, B any](fa IOResult[A], f func(A) B) IOResult[B] {
-	return ioeither.MonadMap(fa, f)
+	return func() Result[B] {
+		a, err := result.Unwrap(fa())
+		if err != nil {
+			return result.Left[B](err)
+		}
+		return result.Of(f(a))
+	}
 }
 
-//go:inline
+// Map is the curried version of [MonadMap]
 func Map[A, B any](f func(A) B) Operator[A, B] {
-	return ioeither.Map[error](f)
+	return func(fa IOResult[A]) IOResult[B] {
+		return MonadMap(fa, f)
+	}
 }
 
 //go:inline
@@ -154,14 +165,24 @@ func MapTo[A, B any](b B) Operator[A, B] {
 	return ioeither.MapTo[error, A](b)
 }
 
-//go:inline
+// MonadChain sequences two [IOResult] computations, the second one depends on the value of the first.
+//
+// Like [MonadMap] the implementation is fused into a single closure to keep long pipelines cheap.
 func MonadChain[A, B any](fa IOResult[A], f Kleisli[A, B]) IOResult[B] {
-	return ioeither.MonadChain(fa, f)
+	return func() Result[B] {
+		a, err := result.Unwrap(fa())
+		if err != nil {
+			return result.Left[B](err)
+		}
+		return f(a)()
+	}
 }
 
-//go:inline
+// Chain is the curried version of [MonadChain]
 func Chain[A, B any](f Kleisli[A, B]) Operator[A, B] {
-	return ioeither.Chain(f)
+	return func(fa IOResult[A]) IOResult[B] {
+		return MonadChain(fa, f)
+	}
 }
 
 //go:inline
@@ -245,6 +266,12 @@ func TryCatchError[A any](f func() (A, error)) IOResult[A] {
 	return ioeither.TryCatchError(f)
 }
 
+// Memoize computes the value of the provided [IOResult] lazily but exactly once.
+//
+// The memoized computation is safe for concurrent use: concurrent callers block until the
+// first execution completes and then share its [Result], including a Left. Use [MemoizeOnSuccess]
+// if failures should not be cached.
+//
 //go:inline
 func Memoize[A any](ma IOResult[A]) IOResult[A] {
 	return ioeither.Memoize(ma)
@@ -272,13 +299,35 @@ func BiMap[E, A, B any](f func(error) E, g func(A) B) func(IOResult[A]) ioeither
 	return ioeither.BiMap(f, g)
 }
 
-// Fold converts an IOResult into an IO
+// Fold converts an IOResult into an IO by applying effectful handlers for the error and the success case.
+// The resulting IO is lazy, the IOResult is only executed when the IO is invoked.
+//
+// Example:
+//
+//	report := ioresult.Fold(
+//	    func(err error) IO[string] { return io.Of("failed: " + err.Error()) },
+//	    func(n int) IO[string] { return io.Of(strconv.Itoa(n)) },
+//	)
 //
 //go:inline
 func Fold[A, B any](onLeft func(error) IO[B], onRight io.Kleisli[A, B]) func(IOResult[A]) IO[B] {
 	return ioeither.Fold(onLeft, onRight)
 }
 
+// Match converts an IOResult into an IO by applying pure handlers for the error and the success case.
+//
+// Example:
+//
+//	describe := ioresult.Match(
+//	    func(err error) string { return "failed: " + err.Error() },
+//	    strconv.Itoa,
+//	)
+//
+//go:inline
+func Match[A, B any](onLeft func(error) B, onRight func(A) B) func(IOResult[A]) IO[B] {
+	return ioeither.Match(onLeft, onRight)
+}
+
 // GetOrElse extracts the value or maps the error
 //
 //go:inline
@@ -286,6 +335,8 @@ func GetOrElse[A any](onLeft func(error) IO[A]) func(IOResult[A]) IO[A] {
 	return ioeither.GetOrElse(onLeft)
 }
 
+// GetOrElseOf extracts the value or computes a default from the error using a pure function
+//
 //go:inline
 func GetOrElseOf[A any](onLeft func(error) A) func(IOResult[A]) IO[A] {
 	return ioeither.GetOrElseOf(onLeft)
@@ -378,6 +429,10 @@ func ChainFirstIOK[A, B any](f io.Kleisli[A, B]) Operator[A, A] {
 	return ioeither.ChainFirstIOK[error](f)
 }
 
+// TapIOK observes the value of a Right with an [IO] hook, preserving the original value.
+// Left values pass through unchanged and the hook is not invoked.
+//
+//go:inline
 func TapIOK[A, B any](f io.Kleisli[A, B]) Operator[A, A] {
 	return ioeither.TapIOK[error](f)
 }
@@ -490,6 +545,29 @@ func TapLeft[A, B any](f Kleisli[error, B]) Operator[A, A] {
 	return ioeither.TapLeft[A](f)
 }
 
+// ChainFirstLeftIOK runs the [IO] returned by the function on the error of a Left but returns the original Left.
+// Right values pass through unchanged and the function is not invoked.
+//
+//go:inline
+func ChainFirstLeftIOK[A, B any](f io.Kleisli[error, B]) Operator[A, A] {
+	return ioeither.ChainFirstLeftIOK[A](f)
+}
+
+// TapLeftIOK observes the error of a Left with an [IO] hook, re-raising the original error.
+// Right values pass through unchanged and the hook is not invoked.
+//
+// Example:
+//
+//	logged := F.Pipe1(
+//	    fetch,
+//	    ioresult.TapLeftIOK[[]byte](io.Logf[error]("fetch failed: %v")),
+//	)
+//
+//go:inline
+func TapLeftIOK[A, B any](f io.Kleisli[error, B]) Operator[A, A] {
+	return ioeither.TapLeftIOK[A](f)
+}
+
 // OrElse recovers from a Left (error) by providing an alternative computation.
 // If the IOResult is Right, it returns the value unchanged.
 // If the IOResult is Left, it applies the provided function to the error value,
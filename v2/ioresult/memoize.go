@@ -0,0 +1,57 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/fp-go/v2/result"
+)
+
+// MemoizeOnSuccess computes the value of the provided [IOResult] lazily and caches it once it succeeds.
+//
+// In contrast to [Memoize] a Left is not cached, so the next invocation executes the computation again.
+// Concurrent callers are serialized, i.e. at most one execution of the computation is in flight at any time
+// and callers waiting for it observe the cached value as soon as one execution succeeded.
+//
+// Example:
+//
+//	token := ioresult.MemoizeOnSuccess(fetchToken)
+//	token() // executes fetchToken, retried on the next call if it fails
+func MemoizeOnSuccess[A any](ma IOResult[A]) IOResult[A] {
+	var mu sync.Mutex
+	var done atomic.Bool
+	var value Result[A]
+
+	return func() Result[A] {
+		// fast path without locking
+		if done.Load() {
+			return value
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if done.Load() {
+			return value
+		}
+		res := ma()
+		if result.IsRight(res) {
+			value = res
+			done.Store(true)
+		}
+		return res
+	}
+}
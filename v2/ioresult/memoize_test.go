@@ -0,0 +1,101 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// hammer invokes the computation from n goroutines at the same time and returns all results
+func hammer[A any](n int, ma IOResult[A]) []Result[A] {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	results := make([]Result[A], n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			results[i] = ma()
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return results
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	var count atomic.Int32
+	memo := Memoize(func() Result[int32] {
+		time.Sleep(time.Millisecond)
+		return result.Of(count.Add(1))
+	})
+
+	for _, res := range hammer(100, memo) {
+		assert.Equal(t, result.Of[int32](1), res)
+	}
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestMemoizeCachesLeft(t *testing.T) {
+	count := 0
+	memo := Memoize(func() Result[int] {
+		count++
+		return result.Left[int](errors.New("failed"))
+	})
+
+	memo()
+	memo()
+	assert.Equal(t, 1, count)
+}
+
+func TestMemoizeOnSuccessConcurrent(t *testing.T) {
+	var count atomic.Int32
+	memo := MemoizeOnSuccess(func() Result[int32] {
+		time.Sleep(time.Millisecond)
+		return result.Of(count.Add(1))
+	})
+
+	for _, res := range hammer(100, memo) {
+		assert.Equal(t, result.Of[int32](1), res)
+	}
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestMemoizeOnSuccessDoesNotCacheLeft(t *testing.T) {
+	errFailed := errors.New("failed")
+	count := 0
+	memo := MemoizeOnSuccess(func() Result[int] {
+		count++
+		if count < 3 {
+			return result.Left[int](errFailed)
+		}
+		return result.Of(count)
+	})
+
+	assert.Equal(t, result.Left[int](errFailed), memo())
+	assert.Equal(t, result.Left[int](errFailed), memo())
+	assert.Equal(t, result.Of(3), memo())
+	assert.Equal(t, result.Of(3), memo())
+	assert.Equal(t, 3, count)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/fp-go/v2/result"
+)
+
+// TimeoutError is the error produced by [WithTimeout] if a computation does not complete in time
+type TimeoutError struct {
+	// Timeout is the duration after which the computation has been abandoned
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("computation did not complete within %s", e.Timeout)
+}
+
+// Race executes both computations concurrently and returns the result of the one that completes first,
+// independent of whether it succeeded or failed.
+//
+// An [IOResult] carries no context, so the losing computation cannot be cancelled: it keeps running in
+// the background and its result is discarded. Use [RaceWithCleanup] to release resources held by the
+// result of the loser.
+//
+// Example:
+//
+//	data := ioresult.Race(fetchFromPrimary, fetchFromMirror)
+func Race[A any](first, second IOResult[A]) IOResult[A] {
+	return func() Result[A] {
+		// buffered, so the loser does not block
+		results := make(chan Result[A], 2)
+		go func() { results <- first() }()
+		go func() { results <- second() }()
+		return <-results
+	}
+}
+
+// RaceWithCleanup executes both computations concurrently and returns the result of the one that completes first.
+//
+// The losing computation keeps running in the background, once it completes its result is passed to the
+// cleanup function and the resulting [IO] is executed, also in the background.
+//
+// Example:
+//
+//	conn := ioresult.RaceWithCleanup(dialPrimary, dialMirror, func(loser result.Result[net.Conn]) io.IO[any] {
+//	    return func() any {
+//	        if conn, err := result.Unwrap(loser); err == nil {
+//	            conn.Close()
+//	        }
+//	        return nil
+//	    }
+//	})
+func RaceWithCleanup[A, ANY any](first, second IOResult[A], cleanup func(Result[A]) IO[ANY]) IOResult[A] {
+	return func() Result[A] {
+		results := make(chan Result[A], 2)
+		go func() { results <- first() }()
+		go func() { results <- second() }()
+		winner := <-results
+		go func() { cleanup(<-results)() }()
+		return winner
+	}
+}
+
+// WithTimeout returns an operator that fails with a [TimeoutError] if the computation does not complete
+// within the given duration.
+//
+// The computation is executed in a separate goroutine. An [IOResult] carries no context, so a computation
+// that times out cannot be cancelled: it keeps running in the background and its late result is discarded.
+//
+// Example:
+//
+//	data := F.Pipe1(fetch, ioresult.WithTimeout[[]byte](5*time.Second))
+//	// Left(*TimeoutError) if fetch takes longer than 5 seconds
+func WithTimeout[A any](d time.Duration) Operator[A, A] {
+	return func(ma IOResult[A]) IOResult[A] {
+		return func() Result[A] {
+			// buffered, so the late computation does not block
+			res := make(chan Result[A], 1)
+			go func() { res <- ma() }()
+
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+
+			select {
+			case r := <-res:
+				return r
+			case <-timer.C:
+				return result.Left[A](&TimeoutError{Timeout: d})
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// gated returns a computation that completes with the given result once the gate is closed
+func gated[A any](gate <-chan struct{}, res Result[A]) IOResult[A] {
+	return func() Result[A] {
+		<-gate
+		return res
+	}
+}
+
+func TestRaceFirstWins(t *testing.T) {
+	gate := make(chan struct{})
+	defer close(gate)
+
+	res := Race(Of(1), gated(gate, result.Of(2)))()
+	assert.Equal(t, result.Of(1), res)
+}
+
+func TestRaceSecondWins(t *testing.T) {
+	gate := make(chan struct{})
+	defer close(gate)
+
+	res := Race(gated(gate, result.Of(1)), Of(2))()
+	assert.Equal(t, result.Of(2), res)
+}
+
+func TestRaceFailureWins(t *testing.T) {
+	errFailed := errors.New("failed")
+	gate := make(chan struct{})
+	defer close(gate)
+
+	res := Race(gated(gate, result.Of(1)), Left[int](errFailed))()
+	assert.Equal(t, result.Left[int](errFailed), res)
+}
+
+func TestRaceWithCleanup(t *testing.T) {
+	gate := make(chan struct{})
+	cleaned := make(chan Result[int], 1)
+	cleanup := func(loser Result[int]) IO[any] {
+		return func() any {
+			cleaned <- loser
+			return nil
+		}
+	}
+
+	res := RaceWithCleanup(gated(gate, result.Of(1)), Of(2), cleanup)()
+	assert.Equal(t, result.Of(2), res)
+
+	// the loser is cleaned up once it completes
+	close(gate)
+	select {
+	case loser := <-cleaned:
+		assert.Equal(t, result.Of(1), loser)
+	case <-time.After(time.Second):
+		assert.Fail(t, "cleanup has not been called")
+	}
+}
+
+func TestWithTimeoutCompletes(t *testing.T) {
+	res := WithTimeout[int](time.Second)(Of(1))()
+	assert.Equal(t, result.Of(1), res)
+
+	errFailed := errors.New("failed")
+	res = WithTimeout[int](time.Second)(Left[int](errFailed))()
+	assert.Equal(t, result.Left[int](errFailed), res)
+}
+
+func TestWithTimeoutExpires(t *testing.T) {
+	gate := make(chan struct{})
+	defer close(gate)
+
+	res := WithTimeout[int](10 * time.Millisecond)(gated(gate, result.Of(1)))()
+
+	var timeoutErr *TimeoutError
+	assert.ErrorAs(t, result.ToError(res), &timeoutErr)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+	assert.Equal(t, "computation did not complete within 10ms", timeoutErr.Error())
+}
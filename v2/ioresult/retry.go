@@ -16,16 +16,39 @@
 package ioresult
 
 import (
+	"time"
+
+	F "github.com/IBM/fp-go/v2/function"
 	"github.com/IBM/fp-go/v2/ioeither"
+	O "github.com/IBM/fp-go/v2/option"
+	"github.com/IBM/fp-go/v2/result"
 	R "github.com/IBM/fp-go/v2/retry"
 )
 
+// OnRetry is a hook that is invoked before each sleep of a retry loop. It receives the status of the
+// upcoming attempt and the error of the failed attempt. The PreviousDelay of the status holds the
+// delay that is about to be slept and IterNumber the number of the upcoming attempt.
+//
+// The error is nil if the retry was triggered by a successful result.
+type OnRetry = func(R.RetryStatus, error) IO[any]
+
 // Retrying will retry the actions according to the check policy
 //
 // policy - refers to the retry policy
 // action - converts a status into an operation to be executed
 // check  - checks if the result of the action needs to be retried
 //
+// The action receives the current [R.RetryStatus], i.e. the iteration number and the cumulative delay.
+// Between attempts the computation sleeps for the delay returned by the policy. Retrying stops as soon as
+// check returns false or the policy returns None, in both cases the result of the last attempt is returned.
+//
+// Example:
+//
+//	policy := R.Monoid.Concat(R.LimitRetries(5), R.ExponentialBackoff(100*time.Millisecond))
+//	fetch := ioresult.Retrying(policy, func(status R.RetryStatus) ioresult.IOResult[[]byte] {
+//	    return download(url)
+//	}, result.IsLeft[[]byte])
+//
 //go:inline
 func Retrying[A any](
 	policy R.RetryPolicy,
@@ -34,3 +57,43 @@ func Retrying[A any](
 ) IOResult[A] {
 	return ioeither.Retrying(policy, action, check)
 }
+
+// RetryingWithHook works like [Retrying] but invokes the onRetry hook before each sleep, e.g.
+// to log the failure of an attempt. The hook is not invoked after the final attempt, i.e. when
+// check returns false or the policy is exhausted.
+//
+// Example:
+//
+//	logRetry := func(status R.RetryStatus, err error) IO[any] {
+//	    return func() any {
+//	        log.Printf("attempt %d failed: %v", status.IterNumber, err)
+//	        return nil
+//	    }
+//	}
+//	fetch := ioresult.RetryingWithHook(policy, download, result.IsLeft[[]byte], logRetry)
+func RetryingWithHook[A any](
+	policy R.RetryPolicy,
+	action Kleisli[R.RetryStatus, A],
+	check Predicate[Result[A]],
+	onRetry OnRetry,
+) IOResult[A] {
+	return func() Result[A] {
+		// result of the latest attempt, tracked per execution
+		var last Result[A]
+		tracked := func(res Result[A]) bool {
+			last = res
+			return check(res)
+		}
+		// the policy is only consulted for attempts that are going to be retried, so the hook runs
+		// right before the sleep and the policy is evaluated exactly once per attempt
+		hooked := func(status R.RetryStatus) O.Option[time.Duration] {
+			delay := policy(status)
+			if O.IsSome(delay) {
+				_, err := result.Unwrap(last)
+				onRetry(R.ApplyPolicy(F.Constant1[R.RetryStatus](delay), status), err)()
+			}
+			return delay
+		}
+		return Retrying(hooked, action, tracked)()
+	}
+}
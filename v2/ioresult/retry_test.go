@@ -16,11 +16,14 @@
 package ioresult
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	E "github.com/IBM/fp-go/v2/either"
+	F "github.com/IBM/fp-go/v2/function"
+	O "github.com/IBM/fp-go/v2/option"
 	"github.com/IBM/fp-go/v2/result"
 	R "github.com/IBM/fp-go/v2/retry"
 	"github.com/stretchr/testify/assert"
@@ -47,3 +50,151 @@ func TestRetry(t *testing.T) {
 
 	assert.Equal(t, E.Of[error]("Retrying 5"), r())
 }
+
+// recordingPolicy returns a fixed delay for a limited number of retries and records
+// every status it has been consulted with
+func recordingPolicy(limit uint, delay time.Duration, consulted *[]R.RetryStatus) R.RetryPolicy {
+	return func(status R.RetryStatus) O.Option[time.Duration] {
+		*consulted = append(*consulted, status)
+		if status.IterNumber < limit {
+			return O.Some(delay)
+		}
+		return O.None[time.Duration]()
+	}
+}
+
+func TestRetryingFailNTimes(t *testing.T) {
+	var consulted []R.RetryStatus
+	var observed []R.RetryStatus
+	policy := recordingPolicy(10, time.Millisecond, &consulted)
+
+	action := func(status R.RetryStatus) IOResult[int] {
+		return func() Result[int] {
+			observed = append(observed, status)
+			if status.IterNumber < 3 {
+				return result.Left[int](fmt.Errorf("attempt %d failed", status.IterNumber))
+			}
+			return result.Of(int(status.IterNumber))
+		}
+	}
+
+	res := Retrying(policy, action, result.IsLeft[int])()
+
+	assert.Equal(t, result.Of(3), res)
+	// the action has been invoked four times, the policy has been consulted after each failure
+	assert.Len(t, observed, 4)
+	assert.Len(t, consulted, 3)
+	for i, status := range observed {
+		assert.Equal(t, uint(i), status.IterNumber)
+		assert.Equal(t, time.Duration(i)*time.Millisecond, status.CumulativeDelay)
+	}
+	assert.Equal(t, O.None[time.Duration](), observed[0].PreviousDelay)
+	assert.Equal(t, O.Some(time.Millisecond), observed[3].PreviousDelay)
+}
+
+func TestRetryingPolicyExhausted(t *testing.T) {
+	var consulted []R.RetryStatus
+	policy := recordingPolicy(2, time.Millisecond, &consulted)
+
+	attempts := 0
+	action := func(status R.RetryStatus) IOResult[int] {
+		return func() Result[int] {
+			attempts++
+			return result.Left[int](fmt.Errorf("attempt %d failed", status.IterNumber))
+		}
+	}
+
+	res := Retrying(policy, action, result.IsLeft[int])()
+
+	// the last failure is returned
+	assert.Equal(t, "attempt 2 failed", result.ToError(res).Error())
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, consulted, 3)
+}
+
+func TestRetryingStopsWhenCheckFails(t *testing.T) {
+	var consulted []R.RetryStatus
+	policy := recordingPolicy(10, time.Millisecond, &consulted)
+
+	attempts := 0
+	action := func(status R.RetryStatus) IOResult[int] {
+		return func() Result[int] {
+			attempts++
+			return result.Left[int](fmt.Errorf("permanent"))
+		}
+	}
+
+	// never retry, independent of the result
+	res := Retrying(policy, action, F.Constant1[Result[int]](false))()
+
+	assert.True(t, result.IsLeft(res))
+	assert.Equal(t, 1, attempts)
+	assert.Empty(t, consulted)
+}
+
+type retryEvent struct {
+	status R.RetryStatus
+	err    error
+}
+
+func TestRetryingWithHook(t *testing.T) {
+	var events []retryEvent
+	hook := func(status R.RetryStatus, err error) IO[any] {
+		return func() any {
+			events = append(events, retryEvent{status, err})
+			return nil
+		}
+	}
+	errs := []error{errors.New("first"), errors.New("second")}
+	action := func(status R.RetryStatus) IOResult[int] {
+		if status.IterNumber < 2 {
+			return Left[int](errs[status.IterNumber])
+		}
+		return Of(int(status.IterNumber))
+	}
+	policy := R.Monoid.Concat(R.LimitRetries(5), R.ConstantDelay(time.Millisecond))
+
+	r := RetryingWithHook(policy, action, result.IsLeft[int], hook)
+
+	// lazy
+	assert.Empty(t, events)
+
+	assert.Equal(t, result.Of(2), r())
+	assert.Len(t, events, 2)
+
+	assert.Equal(t, uint(1), events[0].status.IterNumber)
+	assert.Equal(t, O.Some(time.Millisecond), events[0].status.PreviousDelay)
+	assert.Equal(t, time.Millisecond, events[0].status.CumulativeDelay)
+	assert.Equal(t, errs[0], events[0].err)
+
+	assert.Equal(t, uint(2), events[1].status.IterNumber)
+	assert.Equal(t, 2*time.Millisecond, events[1].status.CumulativeDelay)
+	assert.Equal(t, errs[1], events[1].err)
+}
+
+func TestRetryingWithHookExhausted(t *testing.T) {
+	calls := 0
+	hook := func(R.RetryStatus, error) IO[any] {
+		return func() any {
+			calls++
+			return nil
+		}
+	}
+	errFailed := errors.New("failed")
+
+	policy, history := R.Collect(R.LimitRetries(3))
+	r := RetryingWithHook(policy, F.Constant1[R.RetryStatus](Left[int](errFailed)), result.IsLeft[int], hook)
+
+	assert.Equal(t, result.Left[int](errFailed), r())
+	// no hook call after the final attempt
+	assert.Equal(t, 3, calls)
+
+	// three retries and the final decision to stop
+	h := history()
+	assert.Len(t, h, 4)
+	for i, status := range h[:3] {
+		assert.Equal(t, uint(i+1), status.IterNumber)
+		assert.Equal(t, O.Some(time.Duration(0)), status.PreviousDelay)
+	}
+	assert.Equal(t, O.None[time.Duration](), h[3].PreviousDelay)
+}
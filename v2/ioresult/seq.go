@@ -0,0 +1,47 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"github.com/IBM/fp-go/v2/result"
+)
+
+// TraverseSeq applies a function returning an IOResult to each element of a sequence and collects
+// the successful values into an array.
+//
+// The sequence is not consumed until the resulting IOResult is executed. Elements are then pulled
+// one at a time and the effect for each element runs before the next element is pulled. On the
+// first Left no further elements are pulled and the error is returned.
+//
+// Example:
+//
+//	readAll := TraverseSeq(file.ReadFile)
+//	contents := readAll(slices.Values(paths))()
+func TraverseSeq[A, B any](f Kleisli[A, B]) Kleisli[Seq[A], []B] {
+	return func(seq Seq[A]) IOResult[[]B] {
+		return func() Result[[]B] {
+			bs := make([]B, 0)
+			for a := range seq {
+				b, err := result.Unwrap(f(a)())
+				if err != nil {
+					return result.Left[[]B](err)
+				}
+				bs = append(bs, b)
+			}
+			return result.Of(bs)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverseSeq(t *testing.T) {
+	errTooLarge := errors.New("too large")
+
+	t.Run("collects all values", func(t *testing.T) {
+		double := func(n int) IOResult[int] { return Of(n * 2) }
+		assert.Equal(t, result.Of([]int{2, 4, 6}), TraverseSeq(double)(slices.Values([]int{1, 2, 3}))())
+	})
+
+	t.Run("is lazy and interleaves pulls with effects", func(t *testing.T) {
+		var log []string
+		gen := func(yield func(int) bool) {
+			for i := range 3 {
+				log = append(log, "pull")
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		effect := func(n int) IOResult[int] {
+			return func() Result[int] {
+				log = append(log, "run")
+				return result.Of(n)
+			}
+		}
+		io := TraverseSeq(effect)(gen)
+		assert.Empty(t, log)
+
+		assert.Equal(t, result.Of([]int{0, 1, 2}), io())
+		assert.Equal(t, []string{"pull", "run", "pull", "run", "pull", "run"}, log)
+	})
+
+	t.Run("stops pulling on first error", func(t *testing.T) {
+		pulled, ran := 0, 0
+		gen := func(yield func(int) bool) {
+			for i := range 10 {
+				pulled++
+				if !yield(i) {
+					return
+				}
+			}
+		}
+		check := func(n int) IOResult[int] {
+			return func() Result[int] {
+				ran++
+				if n >= 2 {
+					return result.Left[int](errTooLarge)
+				}
+				return result.Of(n)
+			}
+		}
+		_, err := result.Unwrap(TraverseSeq(check)(gen)())
+		assert.Equal(t, errTooLarge, err)
+		assert.Equal(t, 3, pulled)
+		assert.Equal(t, 3, ran)
+	})
+}
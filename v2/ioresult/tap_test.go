@@ -0,0 +1,80 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"testing"
+
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTapIOK(t *testing.T) {
+	var observed []int
+	hook := TapIOK(func(n int) IO[string] {
+		return func() string {
+			observed = append(observed, n)
+			return "ignored"
+		}
+	})
+
+	assert.Equal(t, result.Of(1), hook(Of(1))())
+	assert.Equal(t, []int{1}, observed)
+
+	// not invoked on a Left
+	errFailed := errors.New("failed")
+	assert.Equal(t, result.Left[int](errFailed), hook(Left[int](errFailed))())
+	assert.Equal(t, []int{1}, observed)
+}
+
+func TestTapLeftIOK(t *testing.T) {
+	var observed []error
+	hook := TapLeftIOK[int](func(err error) IO[int] {
+		return func() int {
+			observed = append(observed, err)
+			return 42
+		}
+	})
+
+	// the original error is re-raised, the value of the hook is ignored
+	errFailed := errors.New("failed")
+	assert.Equal(t, result.Left[int](errFailed), hook(Left[int](errFailed))())
+	assert.Equal(t, []error{errFailed}, observed)
+
+	// not invoked on a Right
+	assert.Equal(t, result.Of(1), hook(Of(1))())
+	assert.Equal(t, []error{errFailed}, observed)
+}
+
+func TestChainFirstLeftIOKInPipeline(t *testing.T) {
+	count := 0
+	errFailed := errors.New("failed")
+	res := F.Pipe2(
+		Left[int](errFailed),
+		ChainFirstLeftIOK[int](func(error) IO[any] {
+			return func() any {
+				count++
+				return nil
+			}
+		}),
+		Map(func(n int) int { return n * 2 }),
+	)
+
+	assert.Equal(t, result.Left[int](errFailed), res())
+	assert.Equal(t, 1, count)
+}
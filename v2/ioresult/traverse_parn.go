@@ -0,0 +1,114 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"sync"
+
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/result"
+)
+
+// TraverseArrayWithIndexParN transforms an array by applying an indexed function that returns an [IOResult]
+// to each element, executing at most n computations in parallel.
+//
+// The order of the results corresponds to the order of the input. As soon as one computation fails,
+// no further computations are started and the first error (in time) is returned. Computations that
+// are already running are not interrupted, since an [IOResult] carries no context, but their results
+// are discarded. A value of n smaller than 1 is treated as 1.
+func TraverseArrayWithIndexParN[A, B any](n int, f func(int, A) IOResult[B]) Kleisli[[]A, []B] {
+	workers := max(n, 1)
+	return func(as []A) IOResult[[]B] {
+		return func() Result[[]B] {
+			bs := make([]B, len(as))
+
+			// cancellation token, closed on the first error
+			done := make(chan struct{})
+			var once sync.Once
+			var firstErr error
+			fail := func(err error) {
+				once.Do(func() {
+					firstErr = err
+					close(done)
+				})
+			}
+			cancelled := func() bool {
+				select {
+				case <-done:
+					return true
+				default:
+					return false
+				}
+			}
+
+			jobs := make(chan int)
+			var wg sync.WaitGroup
+			for w := min(workers, len(as)); w > 0; w-- {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range jobs {
+						if cancelled() {
+							continue
+						}
+						rb := f(i, as[i])()
+						if result.IsLeft(rb) {
+							fail(result.ToError(rb))
+							continue
+						}
+						bs[i], _ = result.Unwrap(rb)
+					}
+				}()
+			}
+
+		feed:
+			for i := range as {
+				select {
+				case jobs <- i:
+				case <-done:
+					break feed
+				}
+			}
+			close(jobs)
+			wg.Wait()
+
+			if firstErr != nil {
+				return result.Left[[]B](firstErr)
+			}
+			return result.Of(bs)
+		}
+	}
+}
+
+// TraverseArrayParN transforms an array by applying a function that returns an [IOResult] to each element,
+// executing at most n computations in parallel.
+//
+// The order of the results corresponds to the order of the input. As soon as one computation fails,
+// no further computations are started and the first error (in time) is returned.
+//
+// Example:
+//
+//	fetchAll := ioresult.TraverseArrayParN(4, fetchURL)
+//	pages := fetchAll(urls)() // at most 4 requests in flight
+func TraverseArrayParN[A, B any](n int, f Kleisli[A, B]) Kleisli[[]A, []B] {
+	return TraverseArrayWithIndexParN(n, F.Ignore1of2[int](f))
+}
+
+// SequenceArrayParN converts an array of [IOResult] into an [IOResult] of an array,
+// executing at most n computations in parallel.
+func SequenceArrayParN[A any](n int, ma []IOResult[A]) IOResult[[]A] {
+	return TraverseArrayParN(n, F.Identity[IOResult[A]])(ma)
+}
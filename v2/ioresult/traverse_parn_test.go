@@ -0,0 +1,114 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioresult
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	A "github.com/IBM/fp-go/v2/array"
+	"github.com/IBM/fp-go/v2/result"
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrencyTracker records the maximum number of concurrently running computations
+type concurrencyTracker struct {
+	running atomic.Int32
+	highest atomic.Int32
+	started atomic.Int32
+}
+
+func (c *concurrencyTracker) track(f func() Result[int]) Result[int] {
+	c.started.Add(1)
+	current := c.running.Add(1)
+	defer c.running.Add(-1)
+	for {
+		highest := c.highest.Load()
+		if current <= highest || c.highest.CompareAndSwap(highest, current) {
+			break
+		}
+	}
+	return f()
+}
+
+func TestTraverseArrayParNBounded(t *testing.T) {
+	var tracker concurrencyTracker
+	double := func(n int) IOResult[int] {
+		return func() Result[int] {
+			return tracker.track(func() Result[int] {
+				time.Sleep(time.Millisecond)
+				return result.Of(n * 2)
+			})
+		}
+	}
+
+	input := A.MakeBy(50, func(i int) int { return i })
+	res := TraverseArrayParN(4, double)(input)()
+
+	assert.Equal(t, result.Of(A.MakeBy(50, func(i int) int { return i * 2 })), res)
+	assert.LessOrEqual(t, tracker.highest.Load(), int32(4))
+	assert.Greater(t, tracker.highest.Load(), int32(1))
+	assert.Equal(t, int32(50), tracker.started.Load())
+}
+
+func TestTraverseArrayParNEarlyStop(t *testing.T) {
+	errFailed := errors.New("failed")
+	var tracker concurrencyTracker
+	fail := func(n int) IOResult[int] {
+		return func() Result[int] {
+			return tracker.track(func() Result[int] {
+				if n == 3 {
+					return result.Left[int](errFailed)
+				}
+				time.Sleep(time.Millisecond)
+				return result.Of(n)
+			})
+		}
+	}
+
+	input := A.MakeBy(100, func(i int) int { return i })
+	res := TraverseArrayParN(2, fail)(input)()
+
+	assert.Equal(t, result.Left[[]int](errFailed), res)
+	// no new computations are started after the failure
+	assert.Less(t, tracker.started.Load(), int32(10))
+}
+
+func TestTraverseArrayParNEmpty(t *testing.T) {
+	res := TraverseArrayParN(4, Of[int])([]int{})()
+	assert.Equal(t, result.Of([]int{}), res)
+}
+
+func TestTraverseArrayWithIndexParN(t *testing.T) {
+	withIndex := func(i int, s string) IOResult[string] {
+		return Of(s + string(rune('0'+i)))
+	}
+
+	// n smaller than 1 behaves like 1
+	res := TraverseArrayWithIndexParN(0, withIndex)([]string{"a", "b", "c"})()
+	assert.Equal(t, result.Of([]string{"a0", "b1", "c2"}), res)
+}
+
+func TestSequenceArrayParN(t *testing.T) {
+	res := SequenceArrayParN(2, []IOResult[int]{Of(1), Of(2), Of(3)})()
+	assert.Equal(t, result.Of([]int{1, 2, 3}), res)
+
+	errFailed := errors.New("failed")
+	res = SequenceArrayParN(2, []IOResult[int]{Of(1), Left[int](errFailed), Of(3)})()
+	assert.Equal(t, result.Left[[]int](errFailed), res)
+}
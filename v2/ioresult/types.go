@@ -1,6 +1,8 @@
 package ioresult
 
 import (
+	"iter"
+
 	"github.com/IBM/fp-go/v2/consumer"
 	"github.com/IBM/fp-go/v2/either"
 	"github.com/IBM/fp-go/v2/endomorphism"
@@ -59,4 +61,8 @@ type (
 	Predicate[A any] = predicate.Predicate[A]
 
 	Void = function.Void
+
+	// Seq represents an iterator sequence over values of type A.
+	// It's an alias for Go's standard iter.Seq[A] type.
+	Seq[A any] = iter.Seq[A]
 )
@@ -18,9 +18,12 @@ package testing
 import (
 	"testing"
 
+	E "github.com/IBM/fp-go/v2/eq"
 	AR "github.com/IBM/fp-go/v2/internal/array"
 	M "github.com/IBM/fp-go/v2/monoid"
+	ST "github.com/IBM/fp-go/v2/semigroup/testing"
 	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
 )
 
 func assertLaws[A any](t *testing.T, m M.Monoid[A]) func(a A) bool {
@@ -41,3 +44,50 @@ func AssertLaws[A any](t *testing.T, m M.Monoid[A]) func(data []A) bool {
 		}, true)
 	}
 }
+
+// AssertIdentity returns a function that asserts the left and right identity laws for an operand
+//
+//	concat(empty, a) = a
+//	concat(a, empty) = a
+//
+// The failure message names the law and the operand. The assertion target can be a [*testing.T]
+// or a [*rapid.T].
+func AssertIdentity[A any](t assert.TestingT, eq E.Eq[A], m M.Monoid[A]) func(a A) bool {
+	e := m.Empty()
+	return func(a A) bool {
+		return assert.True(t, eq.Equals(m.Concat(e, a), a), "Monoid left identity: concat(empty, a) = a violated for a=%v", a) &&
+			assert.True(t, eq.Equals(m.Concat(a, e), a), "Monoid right identity: concat(a, empty) = a violated for a=%v", a)
+	}
+}
+
+// AssertLawsEq asserts the monoid laws for a triple of operands, values are compared using eq.
+// In addition to the semigroup laws, see [ST.AssertLaws], it verifies the left and right identity
+// of the empty value for each operand.
+//
+// Example:
+//
+//	laws := AssertLawsEq(t, eq.FromStrictEquals[int](), number.MonoidSum[int]())
+//	assert.True(t, laws(1, 2, 3))
+func AssertLawsEq[A any](t *testing.T, eq E.Eq[A], m M.Monoid[A]) func(a, b, c A) bool {
+	return lawsEq(t, eq, m)
+}
+
+// lawsEq asserts associativity and identity
+func lawsEq[A any](t assert.TestingT, eq E.Eq[A], m M.Monoid[A]) func(a, b, c A) bool {
+	assoc := ST.AssertAssociativity(t, eq, m)
+	identity := AssertIdentity(t, eq, m)
+	return func(a, b, c A) bool {
+		return assoc(a, b, c) && identity(a) && identity(b) && identity(c)
+	}
+}
+
+// CheckLaws verifies the monoid laws for operands produced by the generator using property based testing
+//
+// Example:
+//
+//	CheckLaws(t, eq.FromStrictEquals[int](), number.MonoidSum[int](), rapid.Int())
+func CheckLaws[A any](t *testing.T, eq E.Eq[A], m M.Monoid[A], gen *rapid.Generator[A]) {
+	rapid.Check(t, func(rt *rapid.T) {
+		lawsEq(rt, eq, m)(gen.Draw(rt, "a"), gen.Draw(rt, "b"), gen.Draw(rt, "c"))
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	E "github.com/IBM/fp-go/v2/eq"
+	M "github.com/IBM/fp-go/v2/monoid"
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+// recordingT records the failure messages of assertions
+type recordingT struct {
+	messages []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+var sumMonoid = M.MakeMonoid(func(l, r int) int { return l + r }, 0)
+
+func TestAssertLawsEq(t *testing.T) {
+	laws := AssertLawsEq(t, E.FromStrictEquals[int](), sumMonoid)
+
+	assert.True(t, laws(1, 2, 3))
+	assert.True(t, laws(-1, 0, 1))
+}
+
+func TestAssertIdentityFailure(t *testing.T) {
+	rt := &recordingT{}
+	// 1 is not the identity of the sum
+	wrong := M.MakeMonoid(func(l, r int) int { return l + r }, 1)
+
+	assert.False(t, AssertIdentity(rt, E.FromStrictEquals[int](), wrong)(5))
+	assert.Len(t, rt.messages, 1)
+	assert.Contains(t, rt.messages[0], "Monoid left identity")
+	assert.Contains(t, rt.messages[0], "a=5")
+}
+
+func TestCheckLaws(t *testing.T) {
+	CheckLaws(t, E.FromStrictEquals[int](), sumMonoid, rapid.IntRange(-1000, 1000))
+}
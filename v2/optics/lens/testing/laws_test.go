@@ -18,6 +18,7 @@ package testing
 import (
 	"testing"
 
+	EQ "github.com/IBM/fp-go/v2/eq"
 	EQT "github.com/IBM/fp-go/v2/eq/testing"
 	L "github.com/IBM/fp-go/v2/optics/lens"
 	"github.com/stretchr/testify/assert"
@@ -186,3 +187,22 @@ func TestCompose(t *testing.T) {
 	assert.Equal(t, cpyAddr, sampleAddress)
 	assert.Equal(t, cpyStreet, sampleStreet)
 }
+
+func TestAddrLensLawsWithStructEq(t *testing.T) {
+	streetEq := EQT.PointerOf[Street](EQT.Struct(
+		EQT.Field("num", func(s Street) int { return s.num }, EQ.FromStrictEquals[int]()),
+		EQT.Field("name", func(s Street) string { return s.name }, EQ.FromStrictEquals[string]()),
+	))
+	addrEq := EQT.PointerOf[Address](EQT.Struct(
+		EQT.Field("city", func(a Address) string { return a.city }, EQ.FromStrictEquals[string]()),
+		EQT.Field("street", func(a Address) *Street { return a.street }, streetEq),
+	))
+
+	laws := AssertLaws(
+		t,
+		streetEq,
+		addrEq,
+	)(addrLens)
+
+	assert.True(t, laws(&sampleAddress, &sampleStreet2))
+}
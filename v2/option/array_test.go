@@ -48,3 +48,43 @@ func TestSequenceArray(t *testing.T) {
 		t.Run(fmt.Sprintf("TestSequenceArray %d", i), s(i))
 	}
 }
+
+func TestCompactArrayKeepsOrder(t *testing.T) {
+	ar := []Option[int]{
+		None[int](),
+		Some(3),
+		None[int](),
+		Some(1),
+		Some(2),
+	}
+
+	assert.Equal(t, []int{3, 1, 2}, CompactArray(ar))
+	assert.Equal(t, []int{}, CompactArray([]Option[int]{}))
+	assert.Equal(t, []int{}, CompactArray([]Option[int]{None[int](), None[int]()}))
+}
+
+func TestTraverseArrayWithIndexPassesIndex(t *testing.T) {
+	var indices []int
+	label := TraverseArrayWithIndex(func(i int, s string) Option[string] {
+		indices = append(indices, i)
+		return Some(fmt.Sprintf("%d:%s", i, s))
+	})
+
+	assert.Equal(t, Some([]string{"0:a", "1:b", "2:c"}), label([]string{"a", "b", "c"}))
+	assert.Equal(t, []int{0, 1, 2}, indices)
+
+	assert.Equal(t, Some([]string{}), label([]string{}))
+}
+
+func TestTraverseArrayWithIndexNone(t *testing.T) {
+	// fails if the value at an odd index is empty
+	validate := TraverseArrayWithIndex(func(i int, s string) Option[string] {
+		if i%2 == 1 && s == "" {
+			return None[string]()
+		}
+		return Some(s)
+	})
+
+	assert.Equal(t, Some([]string{"", "b", ""}), validate([]string{"", "b", ""}))
+	assert.Equal(t, None[[]string](), validate([]string{"a", "b", "c", ""}))
+}
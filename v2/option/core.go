@@ -65,6 +65,22 @@ func optMarshalJSON(isSome bool, value any) ([]byte, error) {
 	return jsonNull, nil
 }
 
+// MarshalJSON implements [json.Marshaler] for Option.
+// None is serialized as `null`, Some is serialized as the JSON representation of its value.
+//
+// Since the zero value of an Option is None, fields of type Option can be omitted from the
+// output using the `omitzero` struct tag option.
+//
+// Note that a nested Some(None) is serialized as `null` and therefore decodes as None.
+//
+// Example:
+//
+//	type Person struct {
+//	    Name     string                `json:"name"`
+//	    Nickname option.Option[string] `json:"nickname,omitzero"`
+//	}
+//	json.Marshal(Person{Name: "Carsten"})                             // {"name":"Carsten"}
+//	json.Marshal(Person{Name: "Carsten", Nickname: option.Some("C")}) // {"name":"Carsten","nickname":"C"}
 func (s Option[A]) MarshalJSON() ([]byte, error) {
 	return optMarshalJSON(s.isSome, s.value)
 }
@@ -83,6 +99,11 @@ func optUnmarshalJSON(isSome *bool, value any, data []byte) error {
 	return json.Unmarshal(data, value)
 }
 
+// UnmarshalJSON implements [json.Unmarshaler] for Option.
+// A JSON `null` decodes to None, any other value decodes to Some of the decoded value.
+//
+// If a field is missing from the JSON input, [json.Unmarshal] does not invoke this method,
+// so the Option keeps its previous value (None for a freshly declared struct).
 func (s *Option[A]) UnmarshalJSON(data []byte) error {
 	return optUnmarshalJSON(&s.isSome, &s.value, data)
 }
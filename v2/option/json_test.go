@@ -0,0 +1,99 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonAddress struct {
+	Street string         `json:"street"`
+	Zip    Option[string] `json:"zip"`
+}
+
+type jsonPerson struct {
+	Name     string              `json:"name"`
+	Nickname Option[string]      `json:"nickname,omitzero"`
+	Age      Option[int]         `json:"age"`
+	Address  Option[jsonAddress] `json:"address"`
+}
+
+func roundTrip[A any](t *testing.T, value A) A {
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+
+	var result A
+	assert.NoError(t, json.Unmarshal(data, &result))
+	return result
+}
+
+func TestJSONRoundTripPrimitive(t *testing.T) {
+	assert.Equal(t, Some(42), roundTrip(t, Some(42)))
+	assert.Equal(t, None[int](), roundTrip(t, None[int]()))
+	assert.Equal(t, Some(""), roundTrip(t, Some("")))
+}
+
+func TestJSONRoundTripStruct(t *testing.T) {
+	person := jsonPerson{
+		Name:     "Carsten",
+		Nickname: Some("C"),
+		Age:      Some(42),
+		Address:  Some(jsonAddress{Street: "Main Street", Zip: Some("12345")}),
+	}
+	assert.Equal(t, person, roundTrip(t, person))
+
+	empty := jsonPerson{Name: "Carsten"}
+	assert.Equal(t, empty, roundTrip(t, empty))
+
+	data, err := json.Marshal(empty)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Carsten","age":null,"address":null}`, string(data))
+}
+
+func TestJSONRoundTripNested(t *testing.T) {
+	assert.Equal(t, Some(Some(1)), roundTrip(t, Some(Some(1))))
+	assert.Equal(t, None[Option[int]](), roundTrip(t, None[Option[int]]()))
+	// Some(None) cannot be distinguished from None
+	assert.Equal(t, None[Option[int]](), roundTrip(t, Some(None[int]())))
+
+	assert.Equal(t, []Option[int]{Some(1), None[int]()}, roundTrip(t, []Option[int]{Some(1), None[int]()}))
+}
+
+func TestJSONNullAndMissingField(t *testing.T) {
+	// an explicit null resets the option to None
+	person := jsonPerson{Age: Some(42)}
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"Carsten","age":null}`), &person))
+	assert.Equal(t, None[int](), person.Age)
+
+	// a missing field leaves the option untouched
+	person = jsonPerson{Age: Some(42)}
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"Carsten"}`), &person))
+	assert.Equal(t, Some(42), person.Age)
+
+	// a missing field on a fresh struct is None
+	var fresh jsonPerson
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"Carsten"}`), &fresh))
+	assert.Equal(t, None[int](), fresh.Age)
+	assert.Equal(t, None[jsonAddress](), fresh.Address)
+}
+
+func TestJSONUnmarshalInvalid(t *testing.T) {
+	var value Option[int]
+	assert.Error(t, json.Unmarshal([]byte(`"not a number"`), &value))
+}
@@ -114,6 +114,59 @@ func AltMonoid[A any]() M.Monoid[Option[A]] {
 	)
 }
 
+// AltSemigroup creates a Semigroup for Option[A] using the Alt operation.
+// The semigroup returns the first Some value, or None if both are None.
+//
+// Example:
+//
+//	optSemigroup := AltSemigroup[int]()
+//	optSemigroup.Concat(Some(2), Some(3)) // Some(2)
+//	optSemigroup.Concat(None[int](), Some(3)) // Some(3)
+//
+//go:inline
+func AltSemigroup[A any]() S.Semigroup[Option[A]] {
+	return S.AltSemigroup(MonadAlt[A])
+}
+
+// FirstSome returns the first Some value of the given Options, or None if all Options are None.
+//
+// This is useful for precedence chains such as "flag, else environment, else config".
+//
+// Example:
+//
+//	FirstSome(None[int](), Some(2), Some(3)) // Some(2)
+//	FirstSome(None[int](), None[int]()) // None
+//	FirstSome[int]() // None
+func FirstSome[A any](opts ...Option[A]) Option[A] {
+	for _, opt := range opts {
+		if opt.isSome {
+			return opt
+		}
+	}
+	return None[A]()
+}
+
+// FirstSomeOf evaluates the given thunks in order and returns the first Some value,
+// or None if all thunks return None.
+//
+// In contrast to [FirstSome] the evaluation is lazy: thunks following the first Some are not invoked.
+//
+// Example:
+//
+//	port := FirstSomeOf(
+//	    func() Option[int] { return fromFlag("port") },
+//	    func() Option[int] { return fromEnv("PORT") },
+//	    func() Option[int] { return Some(8080) },
+//	)
+func FirstSomeOf[A any](thunks ...func() Option[A]) Option[A] {
+	for _, thunk := range thunks {
+		if opt := thunk(); opt.isSome {
+			return opt
+		}
+	}
+	return None[A]()
+}
+
 // takeFirst is a helper function that returns the first Some value, or the second if the first is None.
 func takeFirst[A any](l, r Option[A]) Option[A] {
 	if IsSome(l) {
@@ -443,3 +443,54 @@ func TestMonoidLaws(t *testing.T) {
 		assert.Equal(t, a, rightId)
 	})
 }
+
+// TestAltSemigroup tests that AltSemigroup returns the first Some value
+func TestAltSemigroup(t *testing.T) {
+	sg := AltSemigroup[int]()
+
+	assert.Equal(t, Some(1), sg.Concat(Some(1), Some(2)))
+	assert.Equal(t, Some(2), sg.Concat(None[int](), Some(2)))
+	assert.Equal(t, Some(1), sg.Concat(Some(1), None[int]()))
+	assert.Equal(t, None[int](), sg.Concat(None[int](), None[int]()))
+}
+
+// TestFirstSome tests that FirstSome returns the first Some value
+func TestFirstSome(t *testing.T) {
+	assert.Equal(t, Some(2), FirstSome(None[int](), Some(2), Some(3)))
+	assert.Equal(t, Some(1), FirstSome(Some(1)))
+	assert.Equal(t, None[int](), FirstSome(None[int](), None[int]()))
+	assert.Equal(t, None[int](), FirstSome[int]())
+
+	// FirstSome agrees with folding the AltMonoid
+	opts := []Option[int]{None[int](), Some(2), Some(3)}
+	assert.Equal(t, M.ConcatAll(AltMonoid[int]())(opts), FirstSome(opts...))
+}
+
+// TestFirstSomeOf tests that FirstSomeOf stops evaluating after the first Some value
+func TestFirstSomeOf(t *testing.T) {
+	var calls []int
+	thunk := func(idx int, opt Option[int]) func() Option[int] {
+		return func() Option[int] {
+			calls = append(calls, idx)
+			return opt
+		}
+	}
+
+	result := FirstSomeOf(
+		thunk(0, None[int]()),
+		thunk(1, Some(1)),
+		thunk(2, Some(2)),
+	)
+	assert.Equal(t, Some(1), result)
+	assert.Equal(t, []int{0, 1}, calls)
+
+	calls = nil
+	result = FirstSomeOf(
+		thunk(0, None[int]()),
+		thunk(1, None[int]()),
+	)
+	assert.Equal(t, None[int](), result)
+	assert.Equal(t, []int{0, 1}, calls)
+
+	assert.Equal(t, None[int](), FirstSomeOf[int]())
+}
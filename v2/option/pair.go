@@ -37,3 +37,45 @@ func SequencePair[T1, T2 any](t P.Pair[Option[T1], Option[T2]]) Option[P.Pair[T1
 		t,
 	)
 }
+
+// ZipWith combines two Options using a binary function.
+// Returns Some containing the result of f if both Options are Some, None otherwise.
+//
+// Example:
+//
+//	add := ZipWith(func(a, b int) int { return a + b })
+//	result := add(Some(1), Some(2)) // Some(3)
+//	result := add(Some(1), None[int]()) // None
+func ZipWith[A, B, C any](f func(A, B) C) func(Option[A], Option[B]) Option[C] {
+	return func(ma Option[A], mb Option[B]) Option[C] {
+		if ma.isSome && mb.isSome {
+			return Some(f(ma.value, mb.value))
+		}
+		return None[C]()
+	}
+}
+
+// Zip combines two Options into an Option of a Pair.
+// Returns Some containing the pair of values if both Options are Some, None otherwise.
+//
+// Example:
+//
+//	result := Zip(Some(1), Some("hello")) // Some(Pair(1, "hello"))
+//	result := Zip(Some(1), None[string]()) // None
+func Zip[A, B any](ma Option[A], mb Option[B]) Option[P.Pair[A, B]] {
+	return ZipWith(P.MakePair[A, B])(ma, mb)
+}
+
+// Unzip splits an Option of a Pair into a Pair of Options.
+// Returns a Pair of Some values if the Option is Some, a Pair of None values otherwise.
+//
+// Example:
+//
+//	result := Unzip(Some(P.MakePair(1, "hello"))) // Pair(Some(1), Some("hello"))
+//	result := Unzip(None[P.Pair[int, string]]()) // Pair(None, None)
+func Unzip[A, B any](mab Option[P.Pair[A, B]]) P.Pair[Option[A], Option[B]] {
+	if mab.isSome {
+		return P.MakePair(Some(P.Head(mab.value)), Some(P.Tail(mab.value)))
+	}
+	return P.MakePair(None[A](), None[B]())
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"testing"
+
+	P "github.com/IBM/fp-go/v2/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	assert.Equal(t, Some(P.MakePair(1, "a")), Zip(Some(1), Some("a")))
+	assert.Equal(t, None[P.Pair[int, string]](), Zip(Some(1), None[string]()))
+	assert.Equal(t, None[P.Pair[int, string]](), Zip(None[int](), Some("a")))
+	assert.Equal(t, None[P.Pair[int, string]](), Zip(None[int](), None[string]()))
+}
+
+func TestZipWith(t *testing.T) {
+	calls := 0
+	add := ZipWith(func(a, b int) int {
+		calls++
+		return a + b
+	})
+
+	assert.Equal(t, Some(3), add(Some(1), Some(2)))
+	assert.Equal(t, None[int](), add(Some(1), None[int]()))
+	assert.Equal(t, None[int](), add(None[int](), Some(2)))
+	assert.Equal(t, None[int](), add(None[int](), None[int]()))
+	// the function is only invoked if both values are present
+	assert.Equal(t, 1, calls)
+}
+
+func TestUnzip(t *testing.T) {
+	assert.Equal(t, P.MakePair(Some(1), Some("a")), Unzip(Some(P.MakePair(1, "a"))))
+	assert.Equal(t, P.MakePair(None[int](), None[string]()), Unzip(None[P.Pair[int, string]]()))
+
+	// Unzip is the inverse of Zip for Some values
+	unzipped := Unzip(Some(P.MakePair(1, "a")))
+	assert.Equal(t, Some(P.MakePair(1, "a")), Zip(P.Head(unzipped), P.Tail(unzipped)))
+}
@@ -52,3 +52,8 @@ func TestSequenceRecord(t *testing.T) {
 		t.Run(fmt.Sprintf("TestSequenceRecord %d", i), s(i))
 	}
 }
+
+func TestCompactRecordEmpty(t *testing.T) {
+	assert.Equal(t, map[string]int{}, CompactRecord(map[string]Option[int]{}))
+	assert.Equal(t, map[string]int{}, CompactRecord(map[string]Option[int]{"a": None[int]()}))
+}
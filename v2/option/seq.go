@@ -0,0 +1,30 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+// FromSeqFirst returns the first element of a sequence as Some, or None if the sequence is empty.
+// Only the first element is pulled from the sequence.
+//
+// Example:
+//
+//	FromSeqFirst(slices.Values([]int{1, 2, 3})) // Some(1)
+//	FromSeqFirst(slices.Values([]int{}))        // None
+func FromSeqFirst[A any](seq Seq[A]) Option[A] {
+	for a := range seq {
+		return Some(a)
+	}
+	return None[A]()
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSeqFirst(t *testing.T) {
+	pulled := 0
+	gen := func(yield func(int) bool) {
+		for i := 1; i <= 3; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, Some(1), FromSeqFirst(gen))
+	assert.Equal(t, 1, pulled)
+
+	assert.Equal(t, None[int](), FromSeqFirst(slices.Values([]int{})))
+}
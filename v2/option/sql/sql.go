@@ -0,0 +1,172 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql integrates [O.Option] with [database/sql].
+//
+// The [Null] type wraps an Option so it can be used as a scan target and as a query argument,
+// mapping SQL NULL to None. The conversion functions translate between Option and the nullable
+// types of the standard library such as [sql.NullString].
+//
+// Example:
+//
+//	var nickname optsql.Null[string]
+//	err := db.QueryRow("SELECT nickname FROM users WHERE id = ?", id).Scan(&nickname)
+//	// nickname.Option is None if the column is NULL
+package sql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	O "github.com/IBM/fp-go/v2/option"
+)
+
+// Null wraps an [O.Option] and implements [sql.Scanner] and [driver.Valuer].
+//
+// Scanning a SQL NULL produces None, any other value is converted to A using the conversion
+// rules of [sql.Null]. None is written as NULL, Some is written as its value, which must be
+// convertible to a [driver.Value] by the driver.
+type Null[A any] struct {
+	O.Option[A]
+}
+
+var (
+	// make sure we implement the interfaces
+	_ sql.Scanner   = (*Null[string])(nil)
+	_ driver.Valuer = Null[string]{}
+)
+
+// Of wraps an [O.Option] into a [Null]
+func Of[A any](ma O.Option[A]) Null[A] {
+	return Null[A]{ma}
+}
+
+// Scan implements [sql.Scanner]
+func (n *Null[A]) Scan(value any) error {
+	var ns sql.Null[A]
+	if err := ns.Scan(value); err != nil {
+		return err
+	}
+	n.Option = FromNull(ns)
+	return nil
+}
+
+// Value implements [driver.Valuer]
+func (n Null[A]) Value() (driver.Value, error) {
+	return ToNull(n.Option).Value()
+}
+
+// FromNull converts a [sql.Null] into an [O.Option]
+func FromNull[A any](n sql.Null[A]) O.Option[A] {
+	if n.Valid {
+		return O.Some(n.V)
+	}
+	return O.None[A]()
+}
+
+// ToNull converts an [O.Option] into a [sql.Null]
+func ToNull[A any](ma O.Option[A]) sql.Null[A] {
+	v, ok := O.Unwrap(ma)
+	return sql.Null[A]{V: v, Valid: ok}
+}
+
+// FromNullString converts a [sql.NullString] into an [O.Option]
+func FromNullString(n sql.NullString) O.Option[string] {
+	return O.FromValidation(func(n sql.NullString) (string, bool) { return n.String, n.Valid })(n)
+}
+
+// ToNullString converts an [O.Option] into a [sql.NullString]
+func ToNullString(ma O.Option[string]) sql.NullString {
+	v, ok := O.Unwrap(ma)
+	return sql.NullString{String: v, Valid: ok}
+}
+
+// FromNullInt64 converts a [sql.NullInt64] into an [O.Option]
+func FromNullInt64(n sql.NullInt64) O.Option[int64] {
+	return O.FromValidation(func(n sql.NullInt64) (int64, bool) { return n.Int64, n.Valid })(n)
+}
+
+// ToNullInt64 converts an [O.Option] into a [sql.NullInt64]
+func ToNullInt64(ma O.Option[int64]) sql.NullInt64 {
+	v, ok := O.Unwrap(ma)
+	return sql.NullInt64{Int64: v, Valid: ok}
+}
+
+// FromNullInt32 converts a [sql.NullInt32] into an [O.Option]
+func FromNullInt32(n sql.NullInt32) O.Option[int32] {
+	return O.FromValidation(func(n sql.NullInt32) (int32, bool) { return n.Int32, n.Valid })(n)
+}
+
+// ToNullInt32 converts an [O.Option] into a [sql.NullInt32]
+func ToNullInt32(ma O.Option[int32]) sql.NullInt32 {
+	v, ok := O.Unwrap(ma)
+	return sql.NullInt32{Int32: v, Valid: ok}
+}
+
+// FromNullInt16 converts a [sql.NullInt16] into an [O.Option]
+func FromNullInt16(n sql.NullInt16) O.Option[int16] {
+	return O.FromValidation(func(n sql.NullInt16) (int16, bool) { return n.Int16, n.Valid })(n)
+}
+
+// ToNullInt16 converts an [O.Option] into a [sql.NullInt16]
+func ToNullInt16(ma O.Option[int16]) sql.NullInt16 {
+	v, ok := O.Unwrap(ma)
+	return sql.NullInt16{Int16: v, Valid: ok}
+}
+
+// FromNullByte converts a [sql.NullByte] into an [O.Option]
+func FromNullByte(n sql.NullByte) O.Option[byte] {
+	return O.FromValidation(func(n sql.NullByte) (byte, bool) { return n.Byte, n.Valid })(n)
+}
+
+// ToNullByte converts an [O.Option] into a [sql.NullByte]
+func ToNullByte(ma O.Option[byte]) sql.NullByte {
+	v, ok := O.Unwrap(ma)
+	return sql.NullByte{Byte: v, Valid: ok}
+}
+
+// FromNullFloat64 converts a [sql.NullFloat64] into an [O.Option]
+func FromNullFloat64(n sql.NullFloat64) O.Option[float64] {
+	return O.FromValidation(func(n sql.NullFloat64) (float64, bool) { return n.Float64, n.Valid })(n)
+}
+
+// ToNullFloat64 converts an [O.Option] into a [sql.NullFloat64]
+func ToNullFloat64(ma O.Option[float64]) sql.NullFloat64 {
+	v, ok := O.Unwrap(ma)
+	return sql.NullFloat64{Float64: v, Valid: ok}
+}
+
+// FromNullBool converts a [sql.NullBool] into an [O.Option]
+func FromNullBool(n sql.NullBool) O.Option[bool] {
+	return O.FromValidation(func(n sql.NullBool) (bool, bool) { return n.Bool, n.Valid })(n)
+}
+
+// ToNullBool converts an [O.Option] into a [sql.NullBool]
+func ToNullBool(ma O.Option[bool]) sql.NullBool {
+	v, ok := O.Unwrap(ma)
+	return sql.NullBool{Bool: v, Valid: ok}
+}
+
+// FromNullTime converts a [sql.NullTime] into an [O.Option]
+func FromNullTime(n sql.NullTime) O.Option[time.Time] {
+	return O.FromValidation(func(n sql.NullTime) (time.Time, bool) { return n.Time, n.Valid })(n)
+}
+
+// ToNullTime converts an [O.Option] into a [sql.NullTime]
+func ToNullTime(ma O.Option[time.Time]) sql.NullTime {
+	v, ok := O.Unwrap(ma)
+	return sql.NullTime{Time: v, Valid: ok}
+}
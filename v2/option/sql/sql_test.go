@@ -0,0 +1,182 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	O "github.com/IBM/fp-go/v2/option"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver is a minimal [driver.Driver] with a single column table. Exec stores
+// the arguments as the new rows of the table, Query returns all rows.
+type fakeDriver struct {
+	rows []driver.Value
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	values []driver.Value
+	pos    int
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{d}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c, query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.rows = args
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: s.conn.driver.rows}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.pos]
+	r.pos++
+	return nil
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("optionsql", fake)
+}
+
+func openDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("optionsql", "")
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestScanNull(t *testing.T) {
+	db := openDB(t)
+	fake.rows = []driver.Value{nil, "value"}
+
+	rows, err := db.Query("SELECT value")
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var result []O.Option[string]
+	for rows.Next() {
+		var value Null[string]
+		assert.NoError(t, rows.Scan(&value))
+		result = append(result, value.Option)
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, []O.Option[string]{O.None[string](), O.Some("value")}, result)
+}
+
+func TestScanConversion(t *testing.T) {
+	db := openDB(t)
+	fake.rows = []driver.Value{int64(42)}
+
+	var value Null[int]
+	assert.NoError(t, db.QueryRow("SELECT value").Scan(&value))
+	assert.Equal(t, O.Some(42), value.Option)
+
+	fake.rows = []driver.Value{"not a number"}
+	assert.Error(t, db.QueryRow("SELECT value").Scan(&value))
+}
+
+func TestValue(t *testing.T) {
+	db := openDB(t)
+
+	_, err := db.Exec("INSERT", Of(O.Some("value")), Of(O.None[string]()), Of(O.Some(int64(1))))
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{"value", nil, int64(1)}, fake.rows)
+}
+
+func TestValueUnsupportedType(t *testing.T) {
+	db := openDB(t)
+
+	type unsupported struct{}
+	_, err := db.Exec("INSERT", Of(O.Some(unsupported{})))
+	assert.Error(t, err)
+
+	// None does not need to be converted
+	_, err = db.Exec("INSERT", Of(O.None[unsupported]()))
+	assert.NoError(t, err)
+}
+
+func TestNullConversions(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, O.Some("a"), FromNullString(ToNullString(O.Some("a"))))
+	assert.Equal(t, O.None[string](), FromNullString(sql.NullString{}))
+	assert.Equal(t, sql.NullString{String: "a", Valid: true}, ToNullString(O.Some("a")))
+
+	assert.Equal(t, O.Some(int64(1)), FromNullInt64(ToNullInt64(O.Some(int64(1)))))
+	assert.Equal(t, O.None[int64](), FromNullInt64(ToNullInt64(O.None[int64]())))
+	assert.Equal(t, O.Some(int32(1)), FromNullInt32(ToNullInt32(O.Some(int32(1)))))
+	assert.Equal(t, O.Some(int16(1)), FromNullInt16(ToNullInt16(O.Some(int16(1)))))
+	assert.Equal(t, O.Some(byte(1)), FromNullByte(ToNullByte(O.Some(byte(1)))))
+	assert.Equal(t, O.Some(1.5), FromNullFloat64(ToNullFloat64(O.Some(1.5))))
+	assert.Equal(t, O.Some(true), FromNullBool(ToNullBool(O.Some(true))))
+	assert.Equal(t, O.Some(now), FromNullTime(ToNullTime(O.Some(now))))
+	assert.Equal(t, O.None[time.Time](), FromNullTime(ToNullTime(O.None[time.Time]())))
+
+	assert.Equal(t, O.Some(1), FromNull(ToNull(O.Some(1))))
+	assert.Equal(t, sql.Null[int]{}, ToNull(O.None[int]()))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// ParseWith converts a parser returning a value and an error into a function returning an Option.
+// The result is Some if the parser succeeds and None if it returns an error.
+//
+// Example:
+//
+//	parseInt := ParseWith(strconv.Atoi)
+//	result := parseInt("42") // Some(42)
+//	result := parseInt("abc") // None
+func ParseWith[A any](parse func(string) (A, error)) Kleisli[string, A] {
+	return func(s string) Option[A] {
+		a, err := parse(s)
+		if err != nil {
+			return None[A]()
+		}
+		return Some(a)
+	}
+}
+
+// Text wraps an Option of a type that implements [encoding.TextUnmarshaler] and implements
+// [encoding.TextMarshaler] and [encoding.TextUnmarshaler] itself, so Option-like fields survive
+// round trips through encoding packages that rely on the text interfaces, such as configuration
+// decoders for YAML or TOML.
+//
+// An empty text represents None, any other text is decoded into Some. The type parameter is
+// typically a pointer type, e.g. Text[*big.Int] or Text[*net.IP], the pointer is allocated on demand.
+//
+// Note that encoding/json represents a Text as a JSON string, so None is encoded as "" rather than null.
+//
+// Example:
+//
+//	type Config struct {
+//	    Limit option.Text[*big.Int] `json:"limit"`
+//	}
+type Text[A encoding.TextUnmarshaler] struct {
+	Option Option[A]
+}
+
+// MarshalText implements [encoding.TextMarshaler]. None is marshaled as empty text,
+// Some is marshaled using the [encoding.TextMarshaler] implementation of the value.
+func (t Text[A]) MarshalText() ([]byte, error) {
+	a, ok := Unwrap(t.Option)
+	if !ok {
+		return []byte{}, nil
+	}
+	if m, ok := any(a).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return nil, fmt.Errorf("type %T does not implement encoding.TextMarshaler", a)
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. Empty text is unmarshaled as None,
+// any other text is unmarshaled using the [encoding.TextUnmarshaler] implementation of the value.
+func (t *Text[A]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		t.Option = None[A]()
+		return nil
+	}
+	var a A
+	// allocate pointer types, otherwise the value could not be modified
+	if tp := reflect.TypeOf(&a).Elem(); tp.Kind() == reflect.Pointer {
+		a = reflect.New(tp.Elem()).Interface().(A)
+	}
+	if err := a.UnmarshalText(data); err != nil {
+		return err
+	}
+	t.Option = Some(a)
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package option
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type textLevel int
+
+func (l textLevel) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("level-%d", l)), nil
+}
+
+func (l *textLevel) UnmarshalText(data []byte) error {
+	n, err := strconv.Atoi(strings.TrimPrefix(string(data), "level-"))
+	if err != nil {
+		return err
+	}
+	*l = textLevel(n)
+	return nil
+}
+
+type textConfig struct {
+	Level Text[*textLevel] `json:"level" yaml:"level"`
+	Limit Text[*big.Int]   `json:"limit" yaml:"limit"`
+}
+
+func TestParseWith(t *testing.T) {
+	parseInt := ParseWith(strconv.Atoi)
+
+	assert.Equal(t, Some(42), parseInt("42"))
+	assert.Equal(t, None[int](), parseInt("abc"))
+	assert.Equal(t, None[int](), parseInt(""))
+}
+
+func TestTextMarshal(t *testing.T) {
+	level := textLevel(3)
+
+	data, err := Text[*textLevel]{Some(&level)}.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "level-3", string(data))
+
+	data, err = Text[*textLevel]{None[*textLevel]()}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+}
+
+func TestTextUnmarshal(t *testing.T) {
+	var text Text[*textLevel]
+
+	assert.NoError(t, text.UnmarshalText([]byte("level-2")))
+	assert.Equal(t, Some(textLevel(2)), Map(func(l *textLevel) textLevel { return *l })(text.Option))
+
+	assert.NoError(t, text.UnmarshalText([]byte{}))
+	assert.Equal(t, None[*textLevel](), text.Option)
+
+	assert.Error(t, text.UnmarshalText([]byte("level-x")))
+}
+
+func TestTextJSONRoundTrip(t *testing.T) {
+	level := textLevel(5)
+	cfg := textConfig{
+		Level: Text[*textLevel]{Some(&level)},
+		Limit: Text[*big.Int]{Some(big.NewInt(100))},
+	}
+
+	data, err := json.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"level":"level-5","limit":"100"}`, string(data))
+
+	var decoded textConfig
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, cfg, decoded)
+
+	// empty text
+	data, err = json.Marshal(textConfig{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"level":"","limit":""}`, string(data))
+
+	decoded = textConfig{}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, textConfig{}, decoded)
+
+	// malformed text
+	assert.Error(t, json.Unmarshal([]byte(`{"level":"level-x"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"limit":"abc"}`), &decoded))
+}
+
+func TestTextYAMLRoundTrip(t *testing.T) {
+	level := textLevel(7)
+	cfg := textConfig{
+		Level: Text[*textLevel]{Some(&level)},
+		Limit: Text[*big.Int]{None[*big.Int]()},
+	}
+
+	data, err := yaml.Marshal(cfg)
+	assert.NoError(t, err)
+
+	var decoded textConfig
+	assert.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, cfg, decoded)
+
+	// malformed text
+	assert.Error(t, yaml.Unmarshal([]byte("level: level-x\n"), &decoded))
+}
@@ -373,19 +373,8 @@ func Between[A any](o Ord[A]) func(A, A) func(A) bool {
 	}
 }
 
-// compareTime is a helper function that compares two time.Time values.
-// Returns -1 if a is before b, 1 if a is after b, and 0 if they are equal.
-func compareTime(a, b time.Time) int {
-	if a.Before(b) {
-		return -1
-	} else if a.After(b) {
-		return 1
-	}
-	return 0
-}
-
 // OrdTime returns an Ord instance for time.Time values.
-// Times are ordered chronologically using the Before and After methods.
+// Times are ordered chronologically, this is an alias for [Time].
 //
 // Example:
 //
@@ -394,5 +383,5 @@ func compareTime(a, b time.Time) int {
 //	t2 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 //	result := timeOrd.Compare(t1, t2)  // -1 (t1 is before t2)
 func OrdTime() Ord[time.Time] {
-	return MakeOrd(compareTime, time.Time.Equal)
+	return Time()
 }
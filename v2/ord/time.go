@@ -0,0 +1,105 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ord
+
+import (
+	"math"
+	"time"
+
+	M "github.com/IBM/fp-go/v2/monoid"
+)
+
+var (
+	ordDuration = FromStrictCompare[time.Duration]()
+	ordTime     = MakeOrd(time.Time.Compare, time.Time.Equal)
+)
+
+// Duration returns the Ord instance for [time.Duration] values based on their natural order.
+//
+// Example:
+//
+//	sorted := slices.SortedFunc(slices.Values(delays), ord.Duration().Compare)
+func Duration() Ord[time.Duration] {
+	return ordDuration
+}
+
+// Time returns the Ord instance for [time.Time] values based on [time.Time.Compare].
+// Two times are equal if they represent the same instant, independent of their location
+// and of the presence of a monotonic clock reading.
+//
+// Example:
+//
+//	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+//	t2 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	result := ord.Time().Compare(t1, t2)  // -1 (t1 is before t2)
+func Time() Ord[time.Time] {
+	return ordTime
+}
+
+// ClampDuration restricts a duration to the range [low, hi], see [Clamp].
+//
+// Example:
+//
+//	clamp := ord.ClampDuration(time.Second, time.Minute)
+//	result := clamp(time.Hour)  // time.Minute
+func ClampDuration(low, hi time.Duration) func(time.Duration) time.Duration {
+	return Clamp(ordDuration)(low, hi)
+}
+
+// BetweenDuration tests whether a duration lies in the range [lo, hi), see [Between].
+//
+// Example:
+//
+//	valid := ord.BetweenDuration(time.Second, time.Minute)
+//	result := valid(30 * time.Second)  // true
+func BetweenDuration(lo, hi time.Duration) func(time.Duration) bool {
+	return Between(ordDuration)(lo, hi)
+}
+
+// ClampTime restricts a time to the range [low, hi], see [Clamp].
+func ClampTime(low, hi time.Time) func(time.Time) time.Time {
+	return Clamp(ordTime)(low, hi)
+}
+
+// BetweenTime tests whether a time lies in the range [lo, hi), see [Between].
+//
+// Example:
+//
+//	inRange := ord.BetweenTime(start, end)
+//	result := inRange(time.Now())
+func BetweenTime(lo, hi time.Time) func(time.Time) bool {
+	return Between(ordTime)(lo, hi)
+}
+
+// MaxDuration returns a monoid that combines durations by taking the maximum.
+// The empty value is the smallest representable duration.
+//
+// Example:
+//
+//	longest := M.ConcatAll(ord.MaxDuration())(delays)
+func MaxDuration() M.Monoid[time.Duration] {
+	return M.MakeMonoid(Max(ordDuration), time.Duration(math.MinInt64))
+}
+
+// MinDuration returns a monoid that combines durations by taking the minimum.
+// The empty value is the largest representable duration.
+//
+// Example:
+//
+//	shortest := M.ConcatAll(ord.MinDuration())(delays)
+func MinDuration() M.Monoid[time.Duration] {
+	return M.MakeMonoid(Min(ordDuration), time.Duration(math.MaxInt64))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ord
+
+import (
+	"testing"
+	"time"
+
+	M "github.com/IBM/fp-go/v2/monoid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration(t *testing.T) {
+	o := Duration()
+
+	assert.Equal(t, -1, o.Compare(time.Second, time.Minute))
+	assert.Equal(t, 0, o.Compare(time.Minute, 60*time.Second))
+	assert.Equal(t, 1, o.Compare(time.Hour, time.Minute))
+	assert.True(t, o.Equals(time.Minute, 60*time.Second))
+}
+
+func TestTimeMonotonicReadings(t *testing.T) {
+	o := Time()
+
+	now := time.Now()
+	// strips the monotonic clock reading
+	wall := now.Round(0)
+	// same instant in a different location
+	other := now.In(time.FixedZone("UTC+2", 2*60*60))
+
+	assert.Equal(t, 0, o.Compare(now, wall))
+	assert.True(t, o.Equals(now, wall))
+	assert.Equal(t, 0, o.Compare(wall, other))
+	assert.True(t, o.Equals(now, other))
+	assert.Equal(t, -1, o.Compare(wall, now.Add(time.Nanosecond)))
+	assert.Equal(t, 1, o.Compare(now.Add(time.Nanosecond), wall))
+}
+
+func TestClampDuration(t *testing.T) {
+	clamp := ClampDuration(time.Second, time.Minute)
+
+	assert.Equal(t, time.Second, clamp(0))
+	assert.Equal(t, time.Second, clamp(-time.Hour))
+	assert.Equal(t, time.Second, clamp(time.Second))
+	assert.Equal(t, 30*time.Second, clamp(30*time.Second))
+	assert.Equal(t, time.Minute, clamp(time.Minute))
+	assert.Equal(t, time.Minute, clamp(time.Hour))
+
+	// degenerate range
+	assert.Equal(t, time.Second, ClampDuration(time.Second, time.Second)(time.Hour))
+}
+
+func TestBetweenDuration(t *testing.T) {
+	between := BetweenDuration(time.Second, time.Minute)
+
+	assert.False(t, between(0))
+	assert.True(t, between(time.Second))
+	assert.True(t, between(59*time.Second))
+	assert.False(t, between(time.Minute))
+}
+
+func TestClampAndBetweenTime(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	clamp := ClampTime(start, end)
+	between := BetweenTime(start, end)
+
+	before := start.Add(-time.Hour)
+	inside := start.Add(time.Hour)
+	after := end.Add(time.Hour)
+
+	assert.Equal(t, start, clamp(before))
+	assert.Equal(t, inside, clamp(inside))
+	assert.Equal(t, end, clamp(after))
+
+	assert.False(t, between(before))
+	assert.True(t, between(start))
+	assert.True(t, between(inside))
+	assert.False(t, between(end))
+	// same instant as the start but in a different location
+	assert.True(t, between(start.In(time.FixedZone("UTC-5", -5*60*60))))
+}
+
+func TestMaxMinDuration(t *testing.T) {
+	delays := []time.Duration{3 * time.Second, time.Second, 2 * time.Second}
+
+	assert.Equal(t, 3*time.Second, M.ConcatAll(MaxDuration())(delays))
+	assert.Equal(t, time.Second, M.ConcatAll(MinDuration())(delays))
+
+	// the empty value is the identity
+	assert.Equal(t, time.Second, MaxDuration().Concat(MaxDuration().Empty(), time.Second))
+	assert.Equal(t, time.Second, MinDuration().Concat(MinDuration().Empty(), time.Second))
+}
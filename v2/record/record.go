@@ -19,6 +19,7 @@ import (
 	Mg "github.com/IBM/fp-go/v2/magma"
 	"github.com/IBM/fp-go/v2/option"
 	"github.com/IBM/fp-go/v2/ord"
+	"github.com/IBM/fp-go/v2/pair"
 	G "github.com/IBM/fp-go/v2/record/generic"
 )
 
@@ -518,6 +519,29 @@ func FilterMap[K comparable, V1, V2 any](f option.Kleisli[V1, V2]) Operator[K, V
 	return G.FilterMap[Record[K, V1], Record[K, V2]](f)
 }
 
+// FilterMapWithErrors filters and transforms a record like [FilterMap] but in addition reports the keys
+// of the entries that were dropped because the function returned None. The dropped keys are the head of the
+// pair in no particular order, the transformed record is the tail.
+//
+// Example:
+//
+//	record := Record[string, string]{"a": "1", "b": "x"}
+//	res := FilterMapWithErrors[string](option.ParseWith(strconv.Atoi))(record) // Pair(["b"], {"a": 1})
+func FilterMapWithErrors[K comparable, V1, V2 any](f option.Kleisli[V1, V2]) func(Record[K, V1]) pair.Pair[[]K, Record[K, V2]] {
+	return func(r Record[K, V1]) pair.Pair[[]K, Record[K, V2]] {
+		dropped := make([]K, 0)
+		kept := make(Record[K, V2], len(r))
+		for k, v := range r {
+			if b, ok := option.Unwrap(f(v)); ok {
+				kept[k] = b
+			} else {
+				dropped = append(dropped, k)
+			}
+		}
+		return pair.MakePair(dropped, kept)
+	}
+}
+
 // Filter creates a new record with only the entries whose keys match the predicate.
 //
 // The predicate tests keys only, not values. Use FilterWithIndex to test both.
@@ -750,3 +750,32 @@ func TestFromFoldable(t *testing.T) {
 	result := from(entries)
 	assert.Equal(t, map[string]int{"a": 3, "b": 2}, result)
 }
+
+func TestFilterMapWithErrors(t *testing.T) {
+	positive := func(n int) O.Option[string] {
+		if n > 0 {
+			return O.Some(fmt.Sprint(n))
+		}
+		return O.None[string]()
+	}
+
+	src := map[string]int{"a": 1, "b": -1, "c": 2, "d": 0}
+	res := FilterMapWithErrors[string](positive)(src)
+
+	dropped := P.Head(res)
+	sort.Strings(dropped)
+	assert.Equal(t, []string{"b", "d"}, dropped)
+	assert.Equal(t, map[string]string{"a": "1", "c": "2"}, P.Tail(res))
+	// input is not mutated
+	assert.Equal(t, map[string]int{"a": 1, "b": -1, "c": 2, "d": 0}, src)
+
+	// none kept
+	none := FilterMapWithErrors[string](positive)(map[string]int{"a": 0})
+	assert.Equal(t, []string{"a"}, P.Head(none))
+	assert.Empty(t, P.Tail(none))
+
+	// all kept
+	all := FilterMapWithErrors[string](positive)(map[string]int{"a": 1})
+	assert.Empty(t, P.Head(all))
+	assert.Equal(t, map[string]string{"a": "1"}, P.Tail(all))
+}
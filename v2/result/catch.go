@@ -0,0 +1,164 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	ER "github.com/IBM/fp-go/v2/errors"
+)
+
+// CatchAs recovers from errors of a specific type.
+//
+// The handler is invoked only if [errors.As] finds an error of type E in the error chain of a Left,
+// including errors combined via [errors.Join]. All other Left values and all Right values are
+// returned unchanged.
+//
+// Example:
+//
+//	recoverNotExist := result.CatchAs(func(err *fs.PathError) result.Result[[]byte] {
+//	    return result.Of([]byte{})
+//	})
+//	res := recoverNotExist(result.TryCatchError(os.ReadFile("missing.txt"))) // Right([]byte{})
+func CatchAs[E error, A any](handler func(E) Result[A]) Operator[A, A] {
+	return func(ma Result[A]) Result[A] {
+		if IsLeft(ma) {
+			var target E
+			if errors.As(errorOf(ma), &target) {
+				return handler(target)
+			}
+		}
+		return ma
+	}
+}
+
+// MapLeftAs rewrites errors of a specific type.
+//
+// Every error of type E in the error tree of a Left is replaced by the result of f applied to it.
+// Errors combined via [errors.Join] or [ER.Errors] keep their other members. Wrapping errors keep their
+// context, the matched part of their message is replaced by the message of the rewritten error, or the
+// message of the rewritten error is appended if the message of the wrapping error does not contain the
+// matched part. A rewritten wrapping error is still found by [errors.Is] and [errors.As], but its own
+// fields, e.g. the Err field of a *fs.PathError, still refer to the original error. All other Left
+// values and all Right values are returned unchanged.
+//
+// Example:
+//
+//	hideDetails := result.MapLeftAs[*net.OpError, string](func(err *net.OpError) error {
+//	    return errServiceUnavailable
+//	})
+//	// Left("dial failed: dial tcp 10.0.0.1:443: connection refused") becomes Left("dial failed: service unavailable")
+func MapLeftAs[E error, A any](f func(E) error) Operator[A, A] {
+	return func(ma Result[A]) Result[A] {
+		if IsLeft(ma) {
+			if err, ok := rewriteAs(errorOf(ma), f); ok {
+				return Left[A](err)
+			}
+		}
+		return ma
+	}
+}
+
+// rewrittenError is a wrapping error whose wrapped errors have been rewritten by [MapLeftAs]
+type rewrittenError struct {
+	wrapper error
+	msg     string
+	errs    []error
+}
+
+func (e *rewrittenError) Error() string {
+	return e.msg
+}
+
+func (e *rewrittenError) Unwrap() []error {
+	return e.errs
+}
+
+// Is matches the original wrapping error, the errors it wraps are matched via [rewrittenError.Unwrap]
+func (e *rewrittenError) Is(target error) bool {
+	return reflect.TypeOf(e.wrapper).Comparable() && e.wrapper == target
+}
+
+// As matches the original wrapping error, the errors it wraps are matched via [rewrittenError.Unwrap]
+func (e *rewrittenError) As(target any) bool {
+	t := reflect.ValueOf(target)
+	if t.Kind() != reflect.Pointer || t.IsNil() {
+		return false
+	}
+	w := reflect.ValueOf(e.wrapper)
+	if !w.Type().AssignableTo(t.Type().Elem()) {
+		return false
+	}
+	t.Elem().Set(w)
+	return true
+}
+
+// rewriteAs replaces the errors of type E in the tree of err and reports if any error has been replaced
+func rewriteAs[E error](err error, f func(E) error) (error, bool) {
+	if e, ok := err.(E); ok {
+		return f(e), true
+	}
+	var children []error
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if child := u.Unwrap(); child != nil {
+			children = []error{child}
+		}
+	case interface{ Unwrap() []error }:
+		children = u.Unwrap()
+	}
+	if len(children) == 0 {
+		// the error may still match via a custom As method
+		var target E
+		if errors.As(err, &target) {
+			return f(target), true
+		}
+		return err, false
+	}
+
+	rewritten := make([]error, len(children))
+	msg := err.Error()
+	changed := false
+	for i, child := range children {
+		newChild, ok := rewriteAs(child, f)
+		rewritten[i] = newChild
+		if ok {
+			changed = true
+			msg = replaceMessage(msg, child.Error(), newChild.Error())
+		}
+	}
+	if !changed {
+		return err, false
+	}
+	if _, ok := err.(*ER.Errors); ok {
+		return ER.Of(rewritten[0], rewritten[1:]...), true
+	}
+	if ER.IsJoin(err) {
+		return errors.Join(rewritten...), true
+	}
+	return &rewrittenError{wrapper: err, msg: msg, errs: rewritten}, true
+}
+
+// replaceMessage replaces the message of a wrapped error in the message of its wrapper, if the wrapper
+// does not contain the message the new message is appended
+func replaceMessage(msg, old, new string) string {
+	if old != "" && strings.Contains(msg, old) {
+		return strings.Replace(msg, old, new, 1)
+	}
+	return msg + ": " + new
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type notFoundError struct {
+	key string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("%s not found", e.key)
+}
+
+// opaqueError wraps an error without including its message
+type opaqueError struct {
+	err error
+}
+
+func (e *opaqueError) Error() string {
+	return "opaque failure"
+}
+
+func (e *opaqueError) Unwrap() error {
+	return e.err
+}
+
+func TestCatchAs(t *testing.T) {
+	recover := CatchAs(func(err *notFoundError) Result[string] {
+		return Of("default for " + err.key)
+	})
+
+	// right values pass
+	assert.Equal(t, Of("value"), recover(Of("value")))
+
+	// direct match
+	assert.Equal(t, Of("default for a"), recover(Left[string](&notFoundError{"a"})))
+
+	// wrapped match
+	wrapped := fmt.Errorf("lookup failed: %w", &notFoundError{"b"})
+	assert.Equal(t, Of("default for b"), recover(Left[string](wrapped)))
+
+	// joined errors containing a match
+	joined := errors.Join(errors.New("other"), &notFoundError{"c"})
+	assert.Equal(t, Of("default for c"), recover(Left[string](joined)))
+
+	// non matching errors are untouched
+	other := errors.New("other")
+	assert.Equal(t, Left[string](other), recover(Left[string](other)))
+}
+
+func TestMapLeftAs(t *testing.T) {
+	errPublic := errors.New("resource missing")
+	hide := MapLeftAs[*notFoundError, int](func(err *notFoundError) error {
+		return fmt.Errorf("%w: %s", errPublic, err.key)
+	})
+
+	// right values pass
+	assert.Equal(t, Of(1), hide(Of(1)))
+
+	// wrapped match is rewritten, the context is kept
+	res := hide(Left[int](fmt.Errorf("lookup failed: %w", &notFoundError{"a"})))
+	assert.ErrorIs(t, ToError(res), errPublic)
+	assert.EqualError(t, ToError(res), "lookup failed: resource missing: a")
+	var nf *notFoundError
+	assert.False(t, errors.As(ToError(res), &nf))
+
+	// joined errors keep their other members
+	errOther := errors.New("other")
+	res = hide(Left[int](errors.Join(errOther, &notFoundError{"b"})))
+	assert.EqualError(t, ToError(res), "other\nresource missing: b")
+	assert.ErrorIs(t, ToError(res), errOther)
+	assert.ErrorIs(t, ToError(res), errPublic)
+
+	// matches nested in a wrapped join keep siblings and context
+	res = hide(Left[int](fmt.Errorf("batch: %w", errors.Join(errOther, &notFoundError{"c"}))))
+	assert.EqualError(t, ToError(res), "batch: other\nresource missing: c")
+	assert.ErrorIs(t, ToError(res), errOther)
+	assert.ErrorIs(t, ToError(res), errPublic)
+
+	// typed wrappers are still matched
+	pathErr := &fs.PathError{Op: "open", Path: "config.yaml", Err: &notFoundError{"d"}}
+	res = hide(Left[int](pathErr))
+	assert.EqualError(t, ToError(res), "open config.yaml: resource missing: d")
+	assert.ErrorIs(t, ToError(res), pathErr)
+	var pe *fs.PathError
+	assert.ErrorAs(t, ToError(res), &pe)
+	assert.Equal(t, "config.yaml", pe.Path)
+	assert.False(t, errors.As(ToError(res), &nf))
+
+	// the rewritten message is appended if the wrapper does not contain the matched message
+	res = hide(Left[int](&opaqueError{&notFoundError{"e"}}))
+	assert.EqualError(t, ToError(res), "opaque failure: resource missing: e")
+	assert.ErrorIs(t, ToError(res), errPublic)
+
+	// non matching errors are untouched
+	other := errors.New("other")
+	assert.Equal(t, Left[int](other), hide(Left[int](other)))
+}
@@ -48,14 +48,19 @@ func IsRight[A any](val Result[A]) bool {
 // Left creates a new Either representing a Left (error/failure) value.
 // By convention, Left represents the error case.
 //
+// If tracing has been enabled via [EnableTracing], the error is wrapped in a [StackError]
+// that records the call site of Left.
+//
 // Example:
 //
 //	result := either.Left[int](errors.New("something went wrong"))
 //
 //go:inline
 func Left[A any](value error) Result[A] {
+	if tracing.Load() {
+		return tracedLeft[A](value)
+	}
 	return either.Left[A](value)
-
 }
 
 // Right creates a new Either representing a Right (success) value.
@@ -20,6 +20,7 @@
 package result
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/IBM/fp-go/v2/either"
@@ -279,7 +280,7 @@ func Flatten[A any](mma Result[Result[A]]) Result[A] {
 //
 //go:inline
 func TryCatch[FE Endomorphism[error], A any](val A, err error, onThrow FE) Result[A] {
-	return either.TryCatch(val, err, onThrow)
+	return traced(either.TryCatch(val, err, onThrow))
 }
 
 // TryCatchError is a specialized version of [TryCatch] for error types.
@@ -292,7 +293,7 @@ func TryCatch[FE Endomorphism[error], A any](val A, err error, onThrow FE) Resul
 //
 //go:inline
 func TryCatchError[A any](val A, err error) Result[A] {
-	return either.TryCatchError(val, err)
+	return traced(either.TryCatchError(val, err))
 }
 
 // Sequence2 sequences two Either values using a combining function.
@@ -320,7 +321,10 @@ func Sequence3[T1, T2, T3, R any](f func(T1, T2, T3) Result[R]) func(Result[T1],
 //
 //go:inline
 func FromOption[A any](onNone func() error) func(Option[A]) Result[A] {
-	return either.FromOption[A](onNone)
+	fromOption := either.FromOption[A](onNone)
+	return func(ma Option[A]) Result[A] {
+		return traced(fromOption(ma))
+	}
 }
 
 // ToOption converts an Either to an Option, discarding the Left value.
@@ -348,7 +352,10 @@ func ToOption[A any](ma Result[A]) Option[A] {
 //
 //go:inline
 func FromError[A any](f func(a A) error) Kleisli[A, A] {
-	return either.FromError(f)
+	fromError := either.FromError(f)
+	return func(a A) Result[A] {
+		return traced(fromError(a))
+	}
 }
 
 // ToError converts an Result[A] to an error, returning nil for Right values.
@@ -404,7 +411,10 @@ func UnwrapError[A any](ma Result[A]) (A, error) {
 //
 //go:inline
 func FromPredicate[A any](pred func(A) bool, onFalse func(A) error) Kleisli[A, A] {
-	return either.FromPredicate(pred, onFalse)
+	fromPredicate := either.FromPredicate(pred, onFalse)
+	return func(a A) Result[A] {
+		return traced(fromPredicate(a))
+	}
 }
 
 // FromNillable creates an Either from a pointer, using the provided error for nil pointers.
@@ -418,7 +428,30 @@ func FromPredicate[A any](pred func(A) bool, onFalse func(A) error) Kleisli[A, A
 //
 //go:inline
 func FromNillable[A any](e error) func(*A) Result[*A] {
-	return either.FromNillable[A](e)
+	fromNillable := either.FromNillable[A](e)
+	return func(a *A) Result[*A] {
+		return traced(fromNillable(a))
+	}
+}
+
+// FromNillableWith creates a [Result] from a pointer, calling onNil to produce the error for nil pointers.
+//
+// In contrast to [FromNillable] the error is only constructed if the pointer is actually nil, which
+// allows the error to carry context that is expensive to compute.
+//
+// Example:
+//
+//	fromPtr := result.FromNillableWith[Config](func() error { return errors.New("config is missing") })
+//	res := fromPtr(nil) // Left(error)
+//	cfg := Config{}
+//	res := fromPtr(&cfg) // Right(&cfg)
+func FromNillableWith[A any](onNil Lazy[error]) func(*A) Result[*A] {
+	return func(a *A) Result[*A] {
+		if a == nil {
+			return traced(either.Left[*A](onNil()))
+		}
+		return Of(a)
+	}
 }
 
 // GetOrElse extracts the Right value or computes a default from the Left value.
@@ -496,6 +529,35 @@ func OrElse[A any](onLeft Kleisli[error, A]) Operator[A, A] {
 	return either.OrElse(onLeft)
 }
 
+// FirstRightOf evaluates the candidates in order and returns the first Right.
+//
+// The candidates are lazy, so candidates following the first successful one are never evaluated.
+// If all candidates fail, the result is a Left joining the errors of every attempt using [ErrorSemigroup],
+// in the order of the candidates. If no candidates are given, the result is a Left.
+//
+// Example:
+//
+//	parseTime := func(s string) result.Result[time.Time] {
+//	    return result.FirstRightOf(
+//	        func() result.Result[time.Time] { return result.TryCatchError(time.Parse(time.RFC3339, s)) },
+//	        func() result.Result[time.Time] { return parseUnixSeconds(s) },
+//	    )
+//	}
+func FirstRightOf[A any](candidates ...Lazy[Result[A]]) Result[A] {
+	errs := make([]error, 0, len(candidates))
+	for _, candidate := range candidates {
+		res := candidate()
+		if IsRight(res) {
+			return res
+		}
+		errs = append(errs, errorOf(res))
+	}
+	if err := accumulateErrors(errs); err != nil {
+		return Left[A](err)
+	}
+	return Left[A](errors.New("no candidate produced a value"))
+}
+
 // ToType attempts to convert an any value to a specific type, returning Either.
 //
 // Example:
@@ -508,7 +570,10 @@ func OrElse[A any](onLeft Kleisli[error, A]) Operator[A, A] {
 //
 //go:inline
 func ToType[A any](onError func(any) error) Kleisli[any, A] {
-	return either.ToType[A](onError)
+	toType := either.ToType[A](onError)
+	return func(a any) Result[A] {
+		return traced(toType(a))
+	}
 }
 
 // Memoize returns the Either unchanged (Either values are already memoized).
@@ -18,11 +18,13 @@ package result
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 
 	F "github.com/IBM/fp-go/v2/function"
 	"github.com/IBM/fp-go/v2/internal/utils"
 	IO "github.com/IBM/fp-go/v2/io"
+	N "github.com/IBM/fp-go/v2/number"
 	O "github.com/IBM/fp-go/v2/option"
 	S "github.com/IBM/fp-go/v2/string"
 	"github.com/stretchr/testify/assert"
@@ -649,3 +651,87 @@ func TestChainLeft(t *testing.T) {
 		assert.True(t, IsLeft(smartRecover(Left[int](errors.New("unknown")))))
 	})
 }
+
+func TestFromPredicate(t *testing.T) {
+	isPositive := FromPredicate(N.MoreThan(0), func(n int) error {
+		return fmt.Errorf("%d is not positive", n)
+	})
+
+	assert.Equal(t, Of(1), isPositive(1))
+	assert.EqualError(t, ToError(isPositive(-1)), "-1 is not positive")
+
+	// composes with Chain
+	res := F.Pipe2(
+		Of(-2),
+		Chain(isPositive),
+		Map(N.Mul(2)),
+	)
+	assert.EqualError(t, ToError(res), "-2 is not positive")
+	assert.Equal(t, Of(4), F.Pipe2(Of(2), Chain(isPositive), Map(N.Mul(2))))
+}
+
+func TestFromNillableWith(t *testing.T) {
+	errNil := errors.New("value is nil")
+	called := 0
+	fromPtr := FromNillableWith[int](func() error {
+		called++
+		return errNil
+	})
+
+	value := 42
+	assert.Equal(t, Of(&value), fromPtr(&value))
+	assert.Equal(t, 0, called)
+
+	assert.Equal(t, Left[*int](errNil), fromPtr(nil))
+	assert.Equal(t, 1, called)
+
+	// composes with Chain
+	deref := func(p *int) Result[int] { return Of(*p) }
+	assert.Equal(t, Of(42), F.Pipe2(Of(&value), Chain(fromPtr), Chain(deref)))
+	assert.Equal(t, Left[int](errNil), F.Pipe2(Of[*int](nil), Chain(fromPtr), Chain(deref)))
+}
+
+func TestFirstRightOf(t *testing.T) {
+	err1 := errors.New("first")
+	err2 := errors.New("second")
+
+	calls := 0
+	later := func() Result[int] {
+		calls++
+		return Of(3)
+	}
+
+	// later candidates are not evaluated after a success
+	res := FirstRightOf(
+		func() Result[int] { return Left[int](err1) },
+		func() Result[int] { return Of(2) },
+		later,
+	)
+	assert.Equal(t, Of(2), res)
+	assert.Equal(t, 0, calls)
+
+	// all candidates fail
+	res = FirstRightOf(
+		func() Result[int] { return Left[int](err1) },
+		func() Result[int] { return Left[int](err2) },
+	)
+	err := ToError(res)
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+	assert.Equal(t, "first\nsecond", err.Error())
+
+	// no candidates
+	assert.True(t, IsLeft(FirstRightOf[int]()))
+}
+
+func TestOrElseChain(t *testing.T) {
+	errParse := errors.New("parse")
+	parseSeconds := func(s string) Result[int] {
+		return OrElse(func(err error) Result[int] {
+			return Left[int](fmt.Errorf("%q is not a number: %w", s, errParse))
+		})(TryCatchError(strconv.Atoi(s)))
+	}
+
+	assert.Equal(t, Of(10), parseSeconds("10"))
+	assert.ErrorIs(t, ToError(parseSeconds("x")), errParse)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/fp-go/v2/either"
+)
+
+const (
+	jsonLeft  = "left"
+	jsonRight = "right"
+)
+
+type (
+	// EncodedError is the JSON representation of the error held by a Left.
+	// Message holds the error message, Type identifies the kind of error and Data
+	// optionally holds the JSON representation of a typed error.
+	EncodedError struct {
+		Message string          `json:"message"`
+		Type    string          `json:"type,omitempty"`
+		Data    json.RawMessage `json:"data,omitempty"`
+	}
+
+	// ErrorCodec converts errors to and from their JSON representation.
+	// Arbitrary errors do not round trip, so the codec decides how much information is preserved.
+	ErrorCodec struct {
+		Encode func(error) (EncodedError, error)
+		Decode func(EncodedError) error
+	}
+)
+
+// DefaultErrorCodec returns a codec that preserves the message of an error and records its Go type for
+// information. Decoding is lossy, every error is decoded as a plain error carrying the original message.
+// Use [WithError] to round trip typed errors.
+func DefaultErrorCodec() ErrorCodec {
+	return ErrorCodec{
+		Encode: func(err error) (EncodedError, error) {
+			if err == nil {
+				return EncodedError{}, nil
+			}
+			return EncodedError{Message: err.Error(), Type: fmt.Sprintf("%T", err)}, nil
+		},
+		Decode: func(enc EncodedError) error {
+			return errors.New(enc.Message)
+		},
+	}
+}
+
+// WithError extends an [ErrorCodec] so that errors of type T round trip under the given name.
+// An error of type T is found via [errors.As], so wrapped errors and errors traced by [EnableTracing]
+// are encoded as well. T is encoded into the Data field using its own JSON encoding, errors of other
+// types are handled by the codec being extended. Decoding yields the error of type T without its wrappers.
+//
+// Example:
+//
+//	type NotFound struct {
+//	    ID string `json:"id"`
+//	}
+//
+//	func (e *NotFound) Error() string { return "not found: " + e.ID }
+//
+//	codec := WithError[*NotFound]("not_found")(DefaultErrorCodec())
+func WithError[T error](name string) Endomorphism[ErrorCodec] {
+	return func(codec ErrorCodec) ErrorCodec {
+		return ErrorCodec{
+			Encode: func(err error) (EncodedError, error) {
+				var t T
+				if !errors.As(err, &t) {
+					return codec.Encode(err)
+				}
+				data, jerr := json.Marshal(t)
+				if jerr != nil {
+					return EncodedError{}, jerr
+				}
+				return EncodedError{Message: err.Error(), Type: name, Data: data}, nil
+			},
+			Decode: func(enc EncodedError) error {
+				if enc.Type != name {
+					return codec.Decode(enc)
+				}
+				var t T
+				if err := json.Unmarshal(enc.Data, &t); err != nil {
+					return codec.Decode(enc)
+				}
+				return t
+			},
+		}
+	}
+}
+
+// EncodeJSON serializes a [Result] as a tagged union. A Right is encoded as {"right": value},
+// a Left as {"left": {"message": "...", "type": "..."}} using the given [ErrorCodec].
+//
+// Example:
+//
+//	EncodeJSON[int](DefaultErrorCodec())(Of(42))                   // Right([]byte(`{"right":42}`))
+//	EncodeJSON[int](DefaultErrorCodec())(Left[int](errors.New("x"))) // Right([]byte(`{"left":{"message":"x","type":"*errors.errorString"}}`))
+func EncodeJSON[A any](codec ErrorCodec) Kleisli[Result[A], []byte] {
+	return func(ma Result[A]) Result[[]byte] {
+		a, err := either.Unwrap(ma)
+		if either.IsRight(ma) {
+			return either.TryCatchError(json.Marshal(map[string]A{jsonRight: a}))
+		}
+		enc, cerr := codec.Encode(err)
+		if cerr != nil {
+			return either.Left[[]byte](cerr)
+		}
+		return either.TryCatchError(json.Marshal(map[string]EncodedError{jsonLeft: enc}))
+	}
+}
+
+// DecodeJSON parses the tagged union produced by [EncodeJSON]. The outer [Result] reports
+// malformed input, the inner [Result] is the decoded value. Errors are reconstructed using
+// the given [ErrorCodec].
+//
+// Example:
+//
+//	DecodeJSON[int](DefaultErrorCodec())([]byte(`{"right":42}`)) // Right(Right(42))
+func DecodeJSON[A any](codec ErrorCodec) Kleisli[[]byte, Result[A]] {
+	return func(data []byte) Result[Result[A]] {
+		var tagged map[string]json.RawMessage
+		if err := json.Unmarshal(data, &tagged); err != nil {
+			return either.Left[Result[A]](err)
+		}
+		if len(tagged) != 1 {
+			return either.Left[Result[A]](fmt.Errorf("expected exactly one of %q or %q, got %d keys", jsonLeft, jsonRight, len(tagged)))
+		}
+		if raw, ok := tagged[jsonRight]; ok {
+			var a A
+			if err := json.Unmarshal(raw, &a); err != nil {
+				return either.Left[Result[A]](err)
+			}
+			return either.Of[error](either.Of[error](a))
+		}
+		raw, ok := tagged[jsonLeft]
+		if !ok {
+			return either.Left[Result[A]](fmt.Errorf("expected exactly one of %q or %q", jsonLeft, jsonRight))
+		}
+		var enc EncodedError
+		if err := json.Unmarshal(raw, &enc); err != nil {
+			return either.Left[Result[A]](err)
+		}
+		return either.Of[error](either.Left[A](codec.Decode(enc)))
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	jsonPerson struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	missingError struct {
+		ID string `json:"id"`
+	}
+)
+
+func (e *missingError) Error() string {
+	return "not found: " + e.ID
+}
+
+func TestJSONRight(t *testing.T) {
+	encode := EncodeJSON[jsonPerson](DefaultErrorCodec())
+	decode := DecodeJSON[jsonPerson](DefaultErrorCodec())
+
+	person := Of(jsonPerson{Name: "Carsten", Age: 42})
+
+	data, err := Unwrap(encode(person))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"right":{"name":"Carsten","age":42}}`, string(data))
+
+	assert.Equal(t, Of(person), decode(data))
+}
+
+func TestJSONRegisteredError(t *testing.T) {
+	codec := WithError[*missingError]("not_found")(DefaultErrorCodec())
+	encode := EncodeJSON[int](codec)
+	decode := DecodeJSON[int](codec)
+
+	data, err := Unwrap(encode(Left[int](&missingError{ID: "42"})))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"left":{"message":"not found: 42","type":"not_found","data":{"id":"42"}}}`, string(data))
+
+	decoded, err := Unwrap(decode(data))
+	assert.NoError(t, err)
+
+	_, derr := Unwrap(decoded)
+	var nf *missingError
+	assert.ErrorAs(t, derr, &nf)
+	assert.Equal(t, "42", nf.ID)
+}
+
+func TestJSONWrappedRegisteredError(t *testing.T) {
+	enableTracing(t)
+
+	codec := WithError[*missingError]("not_found")(DefaultErrorCodec())
+	encode := EncodeJSON[int](codec)
+	decode := DecodeJSON[int](codec)
+
+	for _, left := range []Result[int]{
+		Left[int](&missingError{ID: "42"}),
+		Left[int](fmt.Errorf("lookup: %w", &missingError{ID: "42"})),
+	} {
+		data, err := Unwrap(encode(left))
+		require.NoError(t, err)
+
+		var tagged map[string]EncodedError
+		require.NoError(t, json.Unmarshal(data, &tagged))
+		assert.Equal(t, "not_found", tagged[jsonLeft].Type)
+		assert.JSONEq(t, `{"id":"42"}`, string(tagged[jsonLeft].Data))
+		assert.Equal(t, ToError(left).Error(), tagged[jsonLeft].Message)
+
+		decoded, err := Unwrap(decode(data))
+		require.NoError(t, err)
+		var nf *missingError
+		assert.ErrorAs(t, ToError(decoded), &nf)
+		assert.Equal(t, "42", nf.ID)
+	}
+}
+
+func TestJSONUnregisteredError(t *testing.T) {
+	codec := WithError[*missingError]("not_found")(DefaultErrorCodec())
+	encode := EncodeJSON[int](codec)
+	decode := DecodeJSON[int](codec)
+
+	data, err := Unwrap(encode(Left[int](errors.New("boom"))))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"left":{"message":"boom","type":"*errors.errorString"}}`, string(data))
+
+	decoded, err := Unwrap(decode(data))
+	assert.NoError(t, err)
+
+	_, derr := Unwrap(decoded)
+	assert.EqualError(t, derr, "boom")
+	var nf *missingError
+	assert.False(t, errors.As(derr, &nf))
+}
+
+func TestDecodeJSONMalformed(t *testing.T) {
+	decode := DecodeJSON[int](DefaultErrorCodec())
+
+	assert.True(t, IsLeft(decode([]byte(`not json`))))
+	assert.True(t, IsLeft(decode([]byte(`{}`))))
+	assert.True(t, IsLeft(decode([]byte(`{"left":{"message":"x"},"right":1}`))))
+	assert.True(t, IsLeft(decode([]byte(`{"middle":1}`))))
+	assert.True(t, IsLeft(decode([]byte(`{"right":"x"}`))))
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"fmt"
+
+	"github.com/IBM/fp-go/v2/pair"
+)
+
+// IndexedError is the error of a failed element of an array together with the position of that element,
+// see [PartitionArrayWithIndex].
+type IndexedError struct {
+	// Index is the position of the failed element in the input array
+	Index int
+	// Err is the error of the failed element
+	Err error
+}
+
+// Error implements the error interface
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("item %d failed: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the failed element
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// PartitionArray splits an array of [Result] values into the errors of the failures and the values
+// of the successes. Both sides preserve the order of the input.
+//
+// Example:
+//
+//	res := result.PartitionArray([]result.Result[int]{
+//	    result.Of(1),
+//	    result.Left[int](errors.New("boom")),
+//	    result.Of(2),
+//	}) // Pair([boom], [1, 2])
+func PartitionArray[A any](as []Result[A]) Pair[[]error, []A] {
+	errs := make([]error, 0, len(as))
+	values := make([]A, 0, len(as))
+	for _, a := range as {
+		if v, err := Unwrap(a); err != nil {
+			errs = append(errs, err)
+		} else {
+			values = append(values, v)
+		}
+	}
+	return pair.MakePair(errs, values)
+}
+
+// PartitionArrayWithIndex works like [PartitionArray] but records the position of each failed element,
+// so error reports can refer to the original input.
+//
+// Example:
+//
+//	failed := pair.Head(result.PartitionArrayWithIndex(results))
+//	for _, err := range failed {
+//	    log.Println(err) // item 7 failed: ...
+//	}
+func PartitionArrayWithIndex[A any](as []Result[A]) Pair[[]IndexedError, []A] {
+	errs := make([]IndexedError, 0, len(as))
+	values := make([]A, 0, len(as))
+	for i, a := range as {
+		if v, err := Unwrap(a); err != nil {
+			errs = append(errs, IndexedError{Index: i, Err: err})
+		} else {
+			values = append(values, v)
+		}
+	}
+	return pair.MakePair(errs, values)
+}
+
+// Lefts returns the errors of all failures in an array of [Result] values, preserving their order.
+func Lefts[A any](as []Result[A]) []error {
+	return pair.Head(PartitionArray(as))
+}
+
+// Rights returns the values of all successes in an array of [Result] values, preserving their order.
+func Rights[A any](as []Result[A]) []A {
+	return pair.Tail(PartitionArray(as))
+}
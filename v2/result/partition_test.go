@@ -0,0 +1,60 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/pair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionArray(t *testing.T) {
+	err1 := errors.New("first")
+	err2 := errors.New("second")
+
+	mixed := []Result[int]{Of(1), Left[int](err1), Of(2), Left[int](err2), Of(3)}
+
+	assert.Equal(t, pair.MakePair([]error{err1, err2}, []int{1, 2, 3}), PartitionArray(mixed))
+	assert.Equal(t, []error{err1, err2}, Lefts(mixed))
+	assert.Equal(t, []int{1, 2, 3}, Rights(mixed))
+
+	// all success
+	assert.Equal(t, pair.MakePair([]error{}, []int{1, 2}), PartitionArray([]Result[int]{Of(1), Of(2)}))
+	// all failure
+	assert.Equal(t, pair.MakePair([]error{err1, err2}, []int{}), PartitionArray([]Result[int]{Left[int](err1), Left[int](err2)}))
+	// empty
+	assert.Equal(t, pair.MakePair([]error{}, []int{}), PartitionArray([]Result[int]{}))
+	assert.Empty(t, Lefts[int](nil))
+	assert.Empty(t, Rights[int](nil))
+}
+
+func TestPartitionArrayWithIndex(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	res := PartitionArrayWithIndex([]Result[string]{Of("a"), Of("b"), Left[string](errBoom), Of("c")})
+
+	assert.Equal(t, []string{"a", "b", "c"}, pair.Tail(res))
+	failed := pair.Head(res)
+	assert.Equal(t, []IndexedError{{Index: 2, Err: errBoom}}, failed)
+	assert.EqualError(t, failed[0], "item 2 failed: boom")
+	assert.ErrorIs(t, failed[0], errBoom)
+
+	empty := PartitionArrayWithIndex([]Result[string]{})
+	assert.Empty(t, pair.Head(empty))
+	assert.Empty(t, pair.Tail(empty))
+}
@@ -16,7 +16,12 @@
 package result
 
 import (
+	"fmt"
+
 	"github.com/IBM/fp-go/v2/either"
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/ord"
+	RR "github.com/IBM/fp-go/v2/record"
 )
 
 // TraverseRecordG transforms a map by applying a function that returns an Either to each value.
@@ -152,3 +157,66 @@ func CompactRecordG[M1 ~map[K]Result[A], M2 ~map[K]A, K comparable, A any](m M1)
 func CompactRecord[K comparable, A any](m map[K]Result[A]) map[K]A {
 	return either.CompactRecord(m)
 }
+
+// TraverseRecordOrdWithIndex transforms a map by applying an indexed function that returns a [Result]
+// to each value, visiting the keys in the order defined by the [ord.Ord] instance.
+//
+// In contrast to [TraverseRecordWithIndex] the error selection is deterministic: the first failing key
+// in sort order short-circuits the traversal and its error is wrapped with the key, so the caller
+// can tell which entry failed. The original error remains accessible via [errors.Is] and [errors.As].
+//
+// Example:
+//
+//	parse := func(k string, v string) result.Result[int] {
+//	    return result.TryCatchError(strconv.Atoi(v))
+//	}
+//	res := result.TraverseRecordOrdWithIndex[string, string, int](S.Ord)(parse)(map[string]string{"a": "1", "b": "x"})
+//	// res is Left(`key b: strconv.Atoi: parsing "x": invalid syntax`)
+func TraverseRecordOrdWithIndex[K comparable, A, B any](o ord.Ord[K]) func(func(K, A) Result[B]) Kleisli[map[K]A, map[K]B] {
+	keys := RR.KeysOrd[A](o)
+	return func(f func(K, A) Result[B]) Kleisli[map[K]A, map[K]B] {
+		return func(ma map[K]A) Result[map[K]B] {
+			bs := make(map[K]B, len(ma))
+			for _, k := range keys(ma) {
+				fb := f(k, ma[k])
+				if IsLeft(fb) {
+					return Left[map[K]B](fmt.Errorf("key %v: %w", k, errorOf(fb)))
+				}
+				bs[k] = valueOf(fb)
+			}
+			return Of(bs)
+		}
+	}
+}
+
+// TraverseRecordOrd transforms a map by applying a function that returns a [Result] to each value,
+// visiting the keys in the order defined by the [ord.Ord] instance.
+//
+// The first failing key in sort order short-circuits the traversal and its error is wrapped with the key.
+//
+// Example:
+//
+//	parse := result.Eitherize1(strconv.Atoi)
+//	res := result.TraverseRecordOrd[string, string, int](S.Ord)(parse)(map[string]string{"a": "1", "b": "2"})
+//	// res is Right(map[string]int{"a": 1, "b": 2})
+func TraverseRecordOrd[K comparable, A, B any](o ord.Ord[K]) func(Kleisli[A, B]) Kleisli[map[K]A, map[K]B] {
+	traverse := TraverseRecordOrdWithIndex[K, A, B](o)
+	return func(f Kleisli[A, B]) Kleisli[map[K]A, map[K]B] {
+		return traverse(F.Ignore1of2[K](f))
+	}
+}
+
+// SequenceRecordOrd converts a map of [Result] values into a [Result] of a map, visiting the keys in the
+// order defined by the [ord.Ord] instance.
+//
+// The first Left in key order is returned, wrapped with its key.
+//
+// Example:
+//
+//	res := result.SequenceRecordOrd[string, int](S.Ord)(map[string]result.Result[int]{
+//	    "a": result.Of(1),
+//	    "b": result.Of(2),
+//	}) // Right(map[string]int{"a": 1, "b": 2})
+func SequenceRecordOrd[K comparable, A any](o ord.Ord[K]) Kleisli[map[K]Result[A], map[K]A] {
+	return TraverseRecordOrd[K, Result[A], A](o)(F.Identity[Result[A]])
+}
@@ -17,8 +17,11 @@ package result
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 
+	S "github.com/IBM/fp-go/v2/string"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,3 +42,44 @@ func TestCompactRecord(t *testing.T) {
 
 	assert.Equal(t, exp, m1)
 }
+
+func TestTraverseRecordOrd(t *testing.T) {
+	parse := Eitherize1(strconv.Atoi)
+	traverse := TraverseRecordOrd[string, string, int](S.Ord)(parse)
+
+	assert.Equal(t, Of(map[string]int{"a": 1, "b": 2}), traverse(map[string]string{"a": "1", "b": "2"}))
+	assert.Equal(t, Of(map[string]int{}), traverse(map[string]string{}))
+}
+
+func TestTraverseRecordOrdKeyedFailure(t *testing.T) {
+	parse := Eitherize1(strconv.Atoi)
+	traverse := TraverseRecordOrd[string, string, int](S.Ord)(parse)
+
+	// the first failing key in sort order is reported, independent of map iteration order
+	for i := 0; i < 10; i++ {
+		err := ToError(traverse(map[string]string{"a": "1", "c": "y", "b": "x"}))
+		assert.EqualError(t, err, `key b: strconv.Atoi: parsing "x": invalid syntax`)
+
+		var numErr *strconv.NumError
+		assert.ErrorAs(t, err, &numErr)
+	}
+}
+
+func TestTraverseRecordOrdWithIndex(t *testing.T) {
+	traverse := TraverseRecordOrdWithIndex[string, int, string](S.Ord)(func(k string, v int) Result[string] {
+		return Of(fmt.Sprintf("%s=%d", k, v))
+	})
+
+	assert.Equal(t, Of(map[string]string{"a": "a=1", "b": "b=2"}), traverse(map[string]int{"a": 1, "b": 2}))
+}
+
+func TestSequenceRecordOrd(t *testing.T) {
+	e := errors.New("error")
+	sequence := SequenceRecordOrd[string, int](S.Ord)
+
+	assert.Equal(t, Of(map[string]int{"a": 1}), sequence(map[string]Result[int]{"a": Of(1)}))
+
+	err := ToError(sequence(map[string]Result[int]{"a": Of(1), "b": Left[int](e)}))
+	assert.ErrorIs(t, err, e)
+	assert.EqualError(t, err, "key b: error")
+}
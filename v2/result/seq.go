@@ -0,0 +1,40 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+// TraverseSeq applies a function returning a Result to each element of a sequence and collects
+// the successful values into an array.
+//
+// The traversal short-circuits on the first Left: no further elements are pulled from the sequence
+// and the error is returned.
+//
+// Example:
+//
+//	parse := func(s string) Result[int] { return TryCatchError(strconv.Atoi(s)) }
+//	TraverseSeq(parse)(slices.Values([]string{"1", "2"})) // Right([1, 2])
+func TraverseSeq[A, B any](f Kleisli[A, B]) Kleisli[Seq[A], []B] {
+	return func(seq Seq[A]) Result[[]B] {
+		bs := make([]B, 0)
+		for a := range seq {
+			b, err := Unwrap(f(a))
+			if err != nil {
+				return Left[[]B](err)
+			}
+			bs = append(bs, b)
+		}
+		return Of(bs)
+	}
+}
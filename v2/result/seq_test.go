@@ -0,0 +1,66 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverseSeq(t *testing.T) {
+	parse := func(s string) Result[int] {
+		return TryCatchError(strconv.Atoi(s))
+	}
+
+	t.Run("all elements succeed", func(t *testing.T) {
+		assert.Equal(t, Of([]int{1, 2, 3}), TraverseSeq(parse)(slices.Values([]string{"1", "2", "3"})))
+	})
+
+	t.Run("empty sequence", func(t *testing.T) {
+		assert.Equal(t, Of([]int{}), TraverseSeq(parse)(slices.Values([]string{})))
+	})
+
+	t.Run("stops pulling on first error", func(t *testing.T) {
+		pulled := 0
+		gen := func(yield func(string) bool) {
+			for _, s := range []string{"1", "x", "3", "4"} {
+				pulled++
+				if !yield(s) {
+					return
+				}
+			}
+		}
+		res := TraverseSeq(parse)(gen)
+		assert.True(t, IsLeft(res))
+		assert.Equal(t, 2, pulled)
+	})
+
+	t.Run("returns the first error", func(t *testing.T) {
+		errOdd := errors.New("odd")
+		even := func(n int) Result[int] {
+			if n%2 != 0 {
+				return Left[int](errOdd)
+			}
+			return Of(n)
+		}
+		_, err := Unwrap(TraverseSeq(even)(slices.Values([]int{2, 3, 5})))
+		assert.Equal(t, errOdd, err)
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/IBM/fp-go/v2/either"
+	"github.com/IBM/fp-go/v2/option"
+)
+
+// maxStackDepth is the maximum number of frames captured by a [StackError]
+const maxStackDepth = 32
+
+type (
+	// Frame describes a single frame of a stack trace captured by a [StackError]
+	Frame = runtime.Frame
+
+	// StackError wraps an error together with the stack trace at the point the error
+	// was wrapped, see [WithStackTrace] and [EnableTracing].
+	StackError struct {
+		err error
+		pcs []uintptr
+	}
+)
+
+// tracing controls if [Left] and the other constructors of a Left capture stack traces
+var tracing atomic.Bool
+
+// EnableTracing turns the capturing of stack traces by [Left] on or off, it is off by default.
+//
+// When tracing is enabled, every error passed to [Left] is wrapped in a [StackError] that records
+// the call site. The same applies to the Left values created by [TryCatch], [TryCatchError],
+// [FromOption], [FromError], [FromPredicate], [FromNillable], [FromNillableWith] and [ToType], so the origin of a Left can be found even after it has passed through a deep
+// pipeline of Chain operations. Use [StackOf] to retrieve the frames.
+//
+// Tracing is meant as a debugging aid. When it is disabled [Left] only performs an additional atomic
+// load and does not allocate.
+func EnableTracing(enabled bool) {
+	tracing.Store(enabled)
+}
+
+// newStackError wraps the error and captures the stack, skipping the given number of frames
+func newStackError(err error, skip int) *StackError {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip, pcs)
+	return &StackError{err: err, pcs: pcs[:n]}
+}
+
+// Error implements the error interface, it returns the message of the wrapped error
+func (s *StackError) Error() string {
+	return s.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (s *StackError) Unwrap() error {
+	return s.err
+}
+
+// Frames returns the frames of the stack trace captured when the error was wrapped,
+// the first frame is the call site
+func (s *StackError) Frames() []Frame {
+	frames := runtime.CallersFrames(s.pcs)
+	result := make([]Frame, 0, len(s.pcs))
+	for {
+		frame, more := frames.Next()
+		result = append(result, frame)
+		if !more {
+			return result
+		}
+	}
+}
+
+// tracedLeft creates a Left and wraps the error in a [StackError] that starts at the caller of the
+// function that invoked tracedLeft, unless the error already carries a stack trace
+func tracedLeft[A any](err error) Result[A] {
+	var stackErr *StackError
+	if err == nil || errors.As(err, &stackErr) {
+		return either.Left[A](err)
+	}
+	// skip runtime.Callers, newStackError, tracedLeft and its caller
+	return either.Left[A, error](newStackError(err, 4))
+}
+
+// traced wraps the error of a Left created by a constructor in a [StackError] if tracing is enabled.
+// The trace starts at the caller of the function that invoked traced, so constructors must call it
+// directly and return its result.
+func traced[A any](ma Result[A]) Result[A] {
+	if !tracing.Load() {
+		return ma
+	}
+	_, err := Unwrap(ma)
+	var stackErr *StackError
+	if err == nil || errors.As(err, &stackErr) {
+		return ma
+	}
+	// skip runtime.Callers, newStackError, traced and the constructor
+	return either.Left[A, error](newStackError(err, 4))
+}
+
+// WithStackTrace wraps the error of a Left in a [StackError] that records the stack trace at the
+// call site of WithStackTrace. A Right and an error that already carries a stack trace are
+// returned unchanged.
+//
+// Example:
+//
+//	res := result.WithStackTrace(result.Left[int](err))
+//	frames := result.StackOf(result.ToError(res)) // Some(frames), starting at the call site
+func WithStackTrace[A any](ma Result[A]) Result[A] {
+	if _, err := Unwrap(ma); err != nil {
+		return tracedLeft[A](err)
+	}
+	return ma
+}
+
+// StackOf returns the frames of the stack trace carried by the error, if any
+//
+// Example:
+//
+//	result.EnableTracing(true)
+//	res := result.Left[int](errors.New("boom"))
+//	frames := result.StackOf(result.ToError(res)) // Some(frames)
+func StackOf(err error) Option[[]Frame] {
+	var stackErr *StackError
+	if errors.As(err, &stackErr) {
+		return option.Some(stackErr.Frames())
+	}
+	return option.None[[]Frame]()
+}
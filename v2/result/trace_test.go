@@ -0,0 +1,184 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/IBM/fp-go/v2/either"
+	F "github.com/IBM/fp-go/v2/function"
+	"github.com/IBM/fp-go/v2/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTrace = errors.New("traced")
+
+// enableTracing turns tracing on for the duration of a test
+func enableTracing(t *testing.T) {
+	EnableTracing(true)
+	t.Cleanup(func() { EnableTracing(false) })
+}
+
+// callSite returns the function name and line of its caller
+func callSite() (string, int) {
+	pc, _, line, _ := runtime.Caller(1)
+	return runtime.FuncForPC(pc).Name(), line
+}
+
+func TestLeftWithoutTracing(t *testing.T) {
+	err := ToError(Left[int](errTrace))
+
+	assert.Same(t, errTrace, err)
+	assert.Equal(t, option.None[[]Frame](), StackOf(err))
+}
+
+func TestLeftWithTracing(t *testing.T) {
+	enableTracing(t)
+
+	fct, line := callSite()
+	res := Left[int](errTrace)
+
+	err := ToError(res)
+	assert.ErrorIs(t, err, errTrace)
+	assert.Equal(t, errTrace.Error(), err.Error())
+
+	frames, ok := option.Unwrap(StackOf(err))
+	require.True(t, ok)
+	require.NotEmpty(t, frames)
+	assert.Equal(t, fct, frames[0].Function)
+	assert.Equal(t, line+1, frames[0].Line)
+	assert.True(t, strings.HasSuffix(frames[0].File, "trace_test.go"))
+}
+
+func TestLeftWithTracingKeepsOrigin(t *testing.T) {
+	enableTracing(t)
+
+	_, line := callSite()
+	origin := Left[int](errTrace)
+	// wrapping again keeps the original stack
+	res := Chain(func(int) Result[int] { return Of(1) })(origin)
+	res = WithStackTrace(Left[int](ToError(res)))
+
+	frames, ok := option.Unwrap(StackOf(ToError(res)))
+	require.True(t, ok)
+	assert.Equal(t, line+1, frames[0].Line)
+}
+
+func TestWithStackTrace(t *testing.T) {
+	fct, line := callSite()
+	res := WithStackTrace(Left[int](errTrace))
+
+	frames, ok := option.Unwrap(StackOf(ToError(res)))
+	require.True(t, ok)
+	assert.Equal(t, fct, frames[0].Function)
+	assert.Equal(t, line+1, frames[0].Line)
+
+	assert.Equal(t, Of(1), WithStackTrace(Of(1)))
+}
+
+func TestLeftWithoutTracingAllocations(t *testing.T) {
+	baseline := testing.AllocsPerRun(100, func() {
+		_ = either.Left[int](errTrace)
+	})
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Left[int](errTrace)
+	})
+
+	assert.Equal(t, baseline, allocs)
+}
+
+func BenchmarkLeftTracing(b *testing.B) {
+	b.Run("disabled", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = Left[int](errTrace)
+		}
+	})
+
+	b.Run("baseline", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = either.Left[int](errTrace)
+		}
+	})
+
+	b.Run("enabled", func(b *testing.B) {
+		EnableTracing(true)
+		defer EnableTracing(false)
+		b.ReportAllocs()
+		for range b.N {
+			_ = Left[int](errTrace)
+		}
+	})
+}
+
+func TestConstructorsWithTracing(t *testing.T) {
+	enableTracing(t)
+
+	// assertTracedAt checks that the error of the Left records the given line of this test as call site
+	assertTracedAt := func(t *testing.T, line int, res Result[int]) {
+		t.Helper()
+		frames, ok := option.Unwrap(StackOf(ToError(res)))
+		require.True(t, ok)
+		assert.Equal(t, line, frames[0].Line)
+		assert.True(t, strings.HasSuffix(frames[0].File, "trace_test.go"))
+	}
+
+	t.Run("TryCatchError", func(t *testing.T) {
+		fct, line := callSite()
+		res := TryCatchError(0, errTrace)
+		assertTracedAt(t, line+1, res)
+		assert.ErrorIs(t, ToError(res), errTrace)
+
+		frames, _ := option.Unwrap(StackOf(ToError(res)))
+		assert.Equal(t, fct, frames[0].Function)
+	})
+
+	t.Run("TryCatch", func(t *testing.T) {
+		_, line := callSite()
+		res := TryCatch(0, errTrace, F.Identity[error])
+		assertTracedAt(t, line+1, res)
+	})
+
+	t.Run("FromPredicate", func(t *testing.T) {
+		positive := FromPredicate(func(n int) bool { return n > 0 }, func(int) error { return errTrace })
+		_, line := callSite()
+		res := positive(-1)
+		assertTracedAt(t, line+1, res)
+	})
+
+	t.Run("FromError", func(t *testing.T) {
+		validate := FromError(func(int) error { return errTrace })
+		_, line := callSite()
+		res := validate(1)
+		assertTracedAt(t, line+1, res)
+	})
+
+	t.Run("FromOption", func(t *testing.T) {
+		fromOption := FromOption[int](func() error { return errTrace })
+		_, line := callSite()
+		res := fromOption(option.None[int]())
+		assertTracedAt(t, line+1, res)
+	})
+
+	t.Run("Right values are not traced", func(t *testing.T) {
+		assert.Equal(t, Of(1), TryCatchError(1, nil))
+	})
+}
@@ -16,6 +16,8 @@
 package result
 
 import (
+	"iter"
+
 	"github.com/IBM/fp-go/v2/either"
 	"github.com/IBM/fp-go/v2/endomorphism"
 	"github.com/IBM/fp-go/v2/lazy"
@@ -64,4 +66,8 @@ type (
 	Predicate[A any] = predicate.Predicate[A]
 
 	Pair[L, R any] = pair.Pair[L, R]
+
+	// Seq represents an iterator sequence over values of type A.
+	// It's an alias for Go's standard iter.Seq[A] type.
+	Seq[A any] = iter.Seq[A]
 )
@@ -0,0 +1,211 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"github.com/IBM/fp-go/v2/either"
+	ER "github.com/IBM/fp-go/v2/errors"
+	M "github.com/IBM/fp-go/v2/monoid"
+	S "github.com/IBM/fp-go/v2/semigroup"
+	T "github.com/IBM/fp-go/v2/tuple"
+)
+
+// ErrorSemigroup returns the [S.Semigroup] that accumulates errors into [ER.Errors], see [ER.ErrorSemigroup].
+//
+// The combined error reports every individual error in its message, consistent with [errors.Join],
+// and [errors.Is] and [errors.As] match each of the original errors.
+//
+// Example:
+//
+//	sg := result.ErrorSemigroup()
+//	err := sg.Concat(errors.New("a"), errors.New("b")) // "a\nb"
+//
+//go:inline
+func ErrorSemigroup() S.Semigroup[error] {
+	return ER.ErrorSemigroup()
+}
+
+// accumulateErrors combines the errors using [ER.Monoid], nil errors are ignored
+func accumulateErrors(errs []error) error {
+	return M.ConcatAll(ER.Monoid())(errs)
+}
+
+// MonadApV is the applicative validation functor for [Result].
+//
+// Unlike [MonadAp] which short-circuits on the first error, MonadApV combines the
+// errors of both the function and the value using the provided semigroup.
+//
+//go:inline
+func MonadApV[B, A any](sg S.Semigroup[error]) func(fab Result[func(a A) B], fa Result[A]) Result[B] {
+	return either.MonadApV[B, A](sg)
+}
+
+// ApV is the curried version of [MonadApV].
+//
+//go:inline
+func ApV[B, A any](sg S.Semigroup[error]) func(Result[A]) Operator[func(A) B, B] {
+	return either.ApV[B, A](sg)
+}
+
+// MonadApValidation applies a function wrapped in a [Result] to a value wrapped in a [Result],
+// accumulating the errors of both sides using [ErrorSemigroup].
+//
+// Example:
+//
+//	fab := result.Left[func(int) int](errors.New("invalid function"))
+//	fa := result.Left[int](errors.New("invalid value"))
+//	res := result.MonadApValidation(fab, fa) // Left("invalid function\ninvalid value")
+func MonadApValidation[B, A any](fab Result[func(a A) B], fa Result[A]) Result[B] {
+	return MonadApV[B, A](ErrorSemigroup())(fab, fa)
+}
+
+// ApValidation is the curried version of [MonadApValidation]. Errors are accumulated
+// using [ErrorSemigroup] rather than short-circuiting on the first error as [Ap] does.
+//
+// Example:
+//
+//	mkUser := func(name string) func(age int) User {
+//	    return func(age int) User { return User{name, age} }
+//	}
+//	res := F.Pipe2(
+//	    result.Map(mkUser)(validateName(name)),
+//	    result.ApValidation[func(int) User](validateAge(age)),
+//	) // reports both the name and the age error
+func ApValidation[B, A any](fa Result[A]) Operator[func(A) B, B] {
+	return ApV[B, A](ErrorSemigroup())(fa)
+}
+
+// errorOf returns the error of a [Result] or nil if the result is a Right
+func errorOf[A any](ma Result[A]) error {
+	if IsLeft(ma) {
+		_, err := Unwrap(ma)
+		return err
+	}
+	return nil
+}
+
+// valueOf returns the value of a [Result], the zero value for a Left
+func valueOf[A any](ma Result[A]) A {
+	a, _ := Unwrap(ma)
+	return a
+}
+
+// concatErrors combines all non nil errors using the semigroup, it returns nil if all errors are nil
+func concatErrors(sg S.Semigroup[error], errs ...error) error {
+	var acc error
+	for _, err := range errs {
+		if err != nil {
+			if acc == nil {
+				acc = err
+			} else {
+				acc = sg.Concat(acc, err)
+			}
+		}
+	}
+	return acc
+}
+
+// SequenceTV2 converts 2 [Result] values into a [Result] of a [T.Tuple2], combining the errors
+// of all Left values using the provided semigroup.
+//
+// In contrast to [SequenceT2] all inputs are inspected, the tuple is only returned if all inputs are Right.
+func SequenceTV2[T1, T2 any](sg S.Semigroup[error]) func(Result[T1], Result[T2]) Result[T.Tuple2[T1, T2]] {
+	return func(t1 Result[T1], t2 Result[T2]) Result[T.Tuple2[T1, T2]] {
+		if err := concatErrors(sg, errorOf(t1), errorOf(t2)); err != nil {
+			return Left[T.Tuple2[T1, T2]](err)
+		}
+		return Of(T.MakeTuple2(valueOf(t1), valueOf(t2)))
+	}
+}
+
+// SequenceTV3 converts 3 [Result] values into a [Result] of a [T.Tuple3], combining the errors
+// of all Left values using the provided semigroup.
+//
+// In contrast to [SequenceT3] all inputs are inspected, the tuple is only returned if all inputs are Right.
+func SequenceTV3[T1, T2, T3 any](sg S.Semigroup[error]) func(Result[T1], Result[T2], Result[T3]) Result[T.Tuple3[T1, T2, T3]] {
+	return func(t1 Result[T1], t2 Result[T2], t3 Result[T3]) Result[T.Tuple3[T1, T2, T3]] {
+		if err := concatErrors(sg, errorOf(t1), errorOf(t2), errorOf(t3)); err != nil {
+			return Left[T.Tuple3[T1, T2, T3]](err)
+		}
+		return Of(T.MakeTuple3(valueOf(t1), valueOf(t2), valueOf(t3)))
+	}
+}
+
+// SequenceTV4 converts 4 [Result] values into a [Result] of a [T.Tuple4], combining the errors
+// of all Left values using the provided semigroup.
+//
+// In contrast to [SequenceT4] all inputs are inspected, the tuple is only returned if all inputs are Right.
+func SequenceTV4[T1, T2, T3, T4 any](sg S.Semigroup[error]) func(Result[T1], Result[T2], Result[T3], Result[T4]) Result[T.Tuple4[T1, T2, T3, T4]] {
+	return func(t1 Result[T1], t2 Result[T2], t3 Result[T3], t4 Result[T4]) Result[T.Tuple4[T1, T2, T3, T4]] {
+		if err := concatErrors(sg, errorOf(t1), errorOf(t2), errorOf(t3), errorOf(t4)); err != nil {
+			return Left[T.Tuple4[T1, T2, T3, T4]](err)
+		}
+		return Of(T.MakeTuple4(valueOf(t1), valueOf(t2), valueOf(t3), valueOf(t4)))
+	}
+}
+
+// SequenceTValidation2 converts 2 [Result] values into a [Result] of a [T.Tuple2].
+//
+// In contrast to [SequenceT2] all inputs are inspected and the errors of all Left values
+// are combined using [ErrorSemigroup].
+func SequenceTValidation2[T1, T2 any](t1 Result[T1], t2 Result[T2]) Result[T.Tuple2[T1, T2]] {
+	return SequenceTV2[T1, T2](ErrorSemigroup())(t1, t2)
+}
+
+// SequenceTValidation3 converts 3 [Result] values into a [Result] of a [T.Tuple3].
+//
+// In contrast to [SequenceT3] all inputs are inspected and the errors of all Left values
+// are combined using [ErrorSemigroup].
+func SequenceTValidation3[T1, T2, T3 any](t1 Result[T1], t2 Result[T2], t3 Result[T3]) Result[T.Tuple3[T1, T2, T3]] {
+	return SequenceTV3[T1, T2, T3](ErrorSemigroup())(t1, t2, t3)
+}
+
+// SequenceTValidation4 converts 4 [Result] values into a [Result] of a [T.Tuple4].
+//
+// In contrast to [SequenceT4] all inputs are inspected and the errors of all Left values
+// are combined using [ErrorSemigroup].
+func SequenceTValidation4[T1, T2, T3, T4 any](t1 Result[T1], t2 Result[T2], t3 Result[T3], t4 Result[T4]) Result[T.Tuple4[T1, T2, T3, T4]] {
+	return SequenceTV4[T1, T2, T3, T4](ErrorSemigroup())(t1, t2, t3, t4)
+}
+
+// TraverseArrayValidation transforms an array by applying a function that returns a [Result] to each element.
+//
+// In contrast to [TraverseArray] the function is applied to all elements and the errors of all
+// failing elements are combined using [ErrorSemigroup].
+//
+// Example:
+//
+//	parse := result.Eitherize1(strconv.Atoi)
+//	res := result.TraverseArrayValidation(parse)([]string{"1", "a", "b"})
+//	// res is Left with the errors for "a" and "b"
+func TraverseArrayValidation[A, B any](f Kleisli[A, B]) Kleisli[[]A, []B] {
+	return func(as []A) Result[[]B] {
+		bs := make([]B, len(as))
+		var errs []error
+		for i, a := range as {
+			fb := f(a)
+			if IsLeft(fb) {
+				errs = append(errs, errorOf(fb))
+				continue
+			}
+			bs[i] = valueOf(fb)
+		}
+		if err := accumulateErrors(errs); err != nil {
+			return Left[[]B](err)
+		}
+		return Of(bs)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package result
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	EQ "github.com/IBM/fp-go/v2/eq"
+	ER "github.com/IBM/fp-go/v2/errors"
+	F "github.com/IBM/fp-go/v2/function"
+	O "github.com/IBM/fp-go/v2/option"
+	SG "github.com/IBM/fp-go/v2/semigroup"
+	ST "github.com/IBM/fp-go/v2/semigroup/testing"
+	T "github.com/IBM/fp-go/v2/tuple"
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+var (
+	errName = errors.New("invalid name")
+	errAge  = errors.New("invalid age")
+)
+
+type validatedUser struct {
+	name string
+	age  int
+}
+
+func mkValidatedUser(name string) func(int) validatedUser {
+	return func(age int) validatedUser {
+		return validatedUser{name, age}
+	}
+}
+
+func TestApValidationCollectsAllErrors(t *testing.T) {
+	res := F.Pipe1(
+		Map(mkValidatedUser)(Left[string](errName)),
+		ApValidation[validatedUser](Left[int](errAge)),
+	)
+
+	assert.True(t, IsLeft(res))
+	err := ToError(res)
+	assert.ErrorIs(t, err, errName)
+	assert.ErrorIs(t, err, errAge)
+	assert.Contains(t, err.Error(), errName.Error())
+	assert.Contains(t, err.Error(), errAge.Error())
+}
+
+func TestApValidationSuccess(t *testing.T) {
+	res := F.Pipe1(
+		Map(mkValidatedUser)(Of("Carsten")),
+		ApValidation[validatedUser](Of(42)),
+	)
+
+	assert.Equal(t, Of(validatedUser{"Carsten", 42}), res)
+}
+
+func TestApValidationSingleError(t *testing.T) {
+	res := MonadApValidation(Map(mkValidatedUser)(Of("Carsten")), Left[int](errAge))
+
+	assert.Equal(t, Left[validatedUser](errAge), res)
+}
+
+func TestErrorSemigroupFlattens(t *testing.T) {
+	err1 := errors.New("1")
+	err2 := errors.New("2")
+	err3 := errors.New("3")
+
+	sg := ErrorSemigroup()
+	err := sg.Concat(sg.Concat(err1, err2), err3)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	assert.True(t, ok)
+	assert.Equal(t, []error{err1, err2, err3}, joined.Unwrap())
+}
+
+func TestErrorSemigroupKeepsWrappedErrors(t *testing.T) {
+	err1 := errors.New("1")
+	err2 := errors.New("2")
+	err3 := errors.New("3")
+	wrapped := fmt.Errorf("ctx: %w / %w", err1, err2)
+
+	err := ErrorSemigroup().Concat(wrapped, err3)
+
+	assert.Equal(t, "ctx: 1 / 2\n3", err.Error())
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+	assert.ErrorIs(t, err, err3)
+}
+
+func TestSequenceTValidation(t *testing.T) {
+	err3 := errors.New("third")
+
+	assert.Equal(t, Of(T.MakeTuple2("a", 1)), SequenceTValidation2(Of("a"), Of(1)))
+	assert.Equal(t, Of(T.MakeTuple3("a", 1, true)), SequenceTValidation3(Of("a"), Of(1), Of(true)))
+
+	res := SequenceTValidation4(Left[string](errName), Of(1), Left[int](errAge), Left[bool](err3))
+	err := ToError(res)
+	assert.ErrorIs(t, err, errName)
+	assert.ErrorIs(t, err, errAge)
+	assert.ErrorIs(t, err, err3)
+}
+
+func TestTraverseArrayValidation(t *testing.T) {
+	parse := Eitherize1(strconv.Atoi)
+
+	assert.Equal(t, Of([]int{1, 2, 3}), TraverseArrayValidation(parse)([]string{"1", "2", "3"}))
+	assert.Equal(t, Of([]int{}), TraverseArrayValidation(parse)([]string{}))
+
+	err := ToError(TraverseArrayValidation(parse)([]string{"1", "a", "b"}))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"a"`)
+	assert.Contains(t, err.Error(), `"b"`)
+
+	var numErr *strconv.NumError
+	assert.ErrorAs(t, err, &numErr)
+}
+
+func TestSequenceTVFailures(t *testing.T) {
+	err1 := errors.New("first")
+	err2 := errors.New("second")
+	err3 := errors.New("third")
+
+	seq := SequenceTV3[string, int, bool](ErrorSemigroup())
+
+	// no failure
+	assert.Equal(t, Of(T.MakeTuple3("a", 1, true)), seq(Of("a"), Of(1), Of(true)))
+
+	// a single failure is reported as is
+	assert.Equal(t, Left[T.Tuple3[string, int, bool]](err2), seq(Of("a"), Left[int](err2), Of(true)))
+
+	// multiple failures are joined
+	err := ToError(seq(Left[string](err1), Left[int](err2), Left[bool](err3)))
+	joined, ok := err.(interface{ Unwrap() []error })
+	assert.True(t, ok)
+	assert.Equal(t, []error{err1, err2, err3}, joined.Unwrap())
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+	assert.ErrorIs(t, err, err3)
+}
+
+func TestSequenceTVCustomSemigroup(t *testing.T) {
+	sg := SG.MakeSemigroup(func(left, right error) error {
+		return fmt.Errorf("%w; %w", left, right)
+	})
+
+	res := SequenceTV2[string, int](sg)(Left[string](errName), Left[int](errAge))
+	assert.EqualError(t, ToError(res), "invalid name; invalid age")
+	assert.ErrorIs(t, ToError(res), errName)
+	assert.ErrorIs(t, ToError(res), errAge)
+
+	res4 := SequenceTV4[string, int, int, int](sg)(Of("a"), Of(1), Of(2), Of(3))
+	assert.Equal(t, Of(T.MakeTuple4("a", 1, 2, 3)), res4)
+}
+
+func TestSequenceTVWithErrors(t *testing.T) {
+	seq := SequenceTV3[string, int, bool](ER.ErrorSemigroup())
+
+	err := ToError(seq(Left[string](errName), Of(1), Left[bool](errAge)))
+
+	errs, ok := O.Unwrap(ER.FromJoin(err))
+	assert.True(t, ok)
+	assert.Equal(t, 2, ER.Len(errs))
+	assert.Equal(t, []error{errName, errAge}, errs.Unwrap())
+}
+
+// flatErrors returns the individual errors of an accumulated or joined error
+func flatErrors(err error) []error {
+	return O.MonadFold(ER.FromJoin(err), F.Constant[[]error](nil), (*ER.Errors).Unwrap)
+}
+
+// flatErrorEq compares errors by their flattened list of errors
+var flatErrorEq = EQ.FromEquals(func(l, r error) bool {
+	return slices.Equal(flatErrors(l), flatErrors(r))
+})
+
+// errorGen generates single errors and joined errors from a fixed set of errors
+var errorGen = rapid.Custom(func(t *rapid.T) error {
+	errs := rapid.SliceOfN(rapid.SampledFrom([]error{errName, errAge}), 1, 3).Draw(t, "errs")
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+})
+
+func TestErrorSemigroupLaws(t *testing.T) {
+	assert.True(t, ST.AssertLaws(t, flatErrorEq, ErrorSemigroup())(errName, errAge, errors.Join(errName, errAge)))
+
+	ST.CheckLaws(t, flatErrorEq, ErrorSemigroup(), errorGen)
+}
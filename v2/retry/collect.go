@@ -0,0 +1,62 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	F "github.com/IBM/fp-go/v2/function"
+)
+
+// Collect wraps a retry policy such that every decision of the policy is recorded.
+// It returns the wrapped policy and a function that returns the history recorded so far.
+//
+// Each entry of the history is the status that results from applying the policy, see
+// [ApplyPolicy], i.e. the IterNumber has been incremented, PreviousDelay holds the decision
+// of the policy and CumulativeDelay includes that delay. The final entry of an exhausted
+// policy carries a PreviousDelay of None.
+//
+// The history is useful for post-mortem reporting, e.g. to log how many attempts an
+// operation took and how long it waited in total. The returned policy is safe for
+// concurrent use.
+//
+// Example:
+//
+//	policy, history := Collect(M.Concat(LimitRetries(3), ConstantDelay(time.Second))(Monoid))
+//	// ... run a retrying operation with policy
+//	attempts := len(history())
+func Collect(policy RetryPolicy) (RetryPolicy, func() []RetryStatus) {
+	var (
+		mu      sync.Mutex
+		history []RetryStatus
+	)
+	collecting := func(status RetryStatus) Option[time.Duration] {
+		delay := policy(status)
+		next := ApplyPolicy(F.Constant1[RetryStatus](delay), status)
+		mu.Lock()
+		defer mu.Unlock()
+		history = append(history, next)
+		return delay
+	}
+	get := func() []RetryStatus {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(history)
+	}
+	return collecting, get
+}
@@ -20,7 +20,7 @@
 //   - Define retry policies that determine when and how long to wait between retries
 //   - Combine multiple policies using monoid operations
 //   - Implement various backoff strategies (constant, exponential, etc.)
-//   - Limit the number of retries or cap the maximum delay
+//   - Limit the number of retries, the total delay or cap the maximum delay
 //
 // # Basic Usage
 //
@@ -221,6 +221,43 @@ func CapDelay(maxDelay time.Duration, policy RetryPolicy) RetryPolicy {
 	)
 }
 
+// CapDelayTo is the curried version of [CapDelay]. It returns an operator that caps
+// the delays of a retry policy at maxDelay, so it can be used in pipelines.
+//
+// Example:
+//
+//	policy := F.Pipe1(
+//		ExponentialBackoff(100*time.Millisecond),
+//		CapDelayTo(5*time.Second),
+//	)
+func CapDelayTo(maxDelay time.Duration) func(RetryPolicy) RetryPolicy {
+	return F.Bind1st(CapDelay, maxDelay)
+}
+
+// CapTotalDelay returns an operator that stops retrying once the cumulative delay
+// would exceed maxDelay. The wrapped policy is consulted first, if the delay it
+// returns added to the delay accumulated so far exceeds maxDelay, None is returned.
+//
+// In contrast to [LimitRetries] this limits the total time spent waiting rather
+// than the number of attempts.
+//
+// Example:
+//
+//	// Exponential backoff, giving up after a total delay of 30 seconds
+//	policy := CapTotalDelay(30*time.Second)(ExponentialBackoff(100*time.Millisecond))
+func CapTotalDelay(maxDelay time.Duration) func(RetryPolicy) RetryPolicy {
+	return func(policy RetryPolicy) RetryPolicy {
+		return func(status RetryStatus) Option[time.Duration] {
+			return F.Pipe1(
+				policy(status),
+				O.Filter(func(delay time.Duration) bool {
+					return status.CumulativeDelay+delay <= maxDelay
+				}),
+			)
+		}
+	}
+}
+
 // ExponentialBackoff creates a retry policy where the delay grows exponentially
 // with each iteration. Each delay increases by a factor of two.
 //
@@ -249,6 +286,32 @@ func ExponentialBackoff(delay time.Duration) RetryPolicy {
 	}
 }
 
+// ExponentialBackoffWithJitter creates a retry policy with exponential backoff and full jitter.
+// The delay for iteration n is chosen uniformly between zero and base * 2^n by multiplying the
+// exponential delay with a value returned by rand, which is expected to lie in [0, 1).
+//
+// Full jitter spreads the retries of concurrent clients over time and avoids synchronized
+// retry storms. The rand function is injectable to allow for deterministic tests, typically
+// [math/rand.Float64] is used.
+//
+// This policy never returns None, so it should be combined with LimitRetries
+// and/or CapTotalDelay to terminate.
+//
+// Example:
+//
+//	policy := M.Concat(
+//		LimitRetries(5),
+//		CapDelay(10*time.Second, ExponentialBackoffWithJitter(100*time.Millisecond, rand.Float64)),
+//	)(Monoid)
+func ExponentialBackoffWithJitter(base time.Duration, rand func() float64) RetryPolicy {
+	return F.Flow2(
+		ExponentialBackoff(base),
+		O.Map(func(delay time.Duration) time.Duration {
+			return time.Duration(rand() * float64(delay))
+		}),
+	)
+}
+
 // DefaultRetryStatus is the initial retry status used when starting a retry operation.
 // It represents the state before any retries have been attempted:
 //   - IterNumber: 0 (first attempt)
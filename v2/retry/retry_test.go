@@ -19,8 +19,11 @@ import (
 	"testing"
 	"time"
 
+	EQ "github.com/IBM/fp-go/v2/eq"
+	MT "github.com/IBM/fp-go/v2/monoid/testing"
 	O "github.com/IBM/fp-go/v2/option"
 	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
 )
 
 func TestIterNumber(t *testing.T) {
@@ -322,3 +325,150 @@ func TestRetryStatusProgression(t *testing.T) {
 		assert.Equal(t, 700*time.Millisecond, status.CumulativeDelay) // No additional delay
 	})
 }
+
+// delaysOf applies the policy until it stops or n delays have been produced
+func delaysOf(policy RetryPolicy, n int) []time.Duration {
+	var delays []time.Duration
+	status := DefaultRetryStatus
+	for range n {
+		status = ApplyPolicy(policy, status)
+		delay, ok := O.Unwrap(status.PreviousDelay)
+		if !ok {
+			break
+		}
+		delays = append(delays, delay)
+	}
+	return delays
+}
+
+func TestExponentialBackoffWithJitter(t *testing.T) {
+	t.Run("scales the exponential delay by the random factor", func(t *testing.T) {
+		policy := ExponentialBackoffWithJitter(100*time.Millisecond, func() float64 { return 0.5 })
+
+		assert.Equal(t, []time.Duration{
+			50 * time.Millisecond,
+			100 * time.Millisecond,
+			200 * time.Millisecond,
+			400 * time.Millisecond,
+		}, delaysOf(policy, 4))
+	})
+
+	t.Run("uses a new random value for each iteration", func(t *testing.T) {
+		values := []float64{0, 0.25, 0.75}
+		idx := 0
+		rand := func() float64 {
+			v := values[idx%len(values)]
+			idx++
+			return v
+		}
+		policy := ExponentialBackoffWithJitter(100*time.Millisecond, rand)
+
+		assert.Equal(t, []time.Duration{
+			0,
+			50 * time.Millisecond,
+			300 * time.Millisecond,
+		}, delaysOf(policy, 3))
+	})
+
+	t.Run("composes with the monoid", func(t *testing.T) {
+		policy := Monoid.Concat(
+			LimitRetries(2),
+			ExponentialBackoffWithJitter(100*time.Millisecond, func() float64 { return 0.5 }),
+		)
+
+		assert.Equal(t, []time.Duration{
+			50 * time.Millisecond,
+			100 * time.Millisecond,
+		}, delaysOf(policy, 10))
+	})
+}
+
+func TestCapTotalDelay(t *testing.T) {
+	t.Run("stops once the cumulative delay would exceed the maximum", func(t *testing.T) {
+		policy := CapTotalDelay(time.Second)(ExponentialBackoff(100 * time.Millisecond))
+
+		// 100 + 200 + 400 = 700, adding 800 would exceed one second
+		assert.Equal(t, []time.Duration{
+			100 * time.Millisecond,
+			200 * time.Millisecond,
+			400 * time.Millisecond,
+		}, delaysOf(policy, 10))
+	})
+
+	t.Run("allows a cumulative delay equal to the maximum", func(t *testing.T) {
+		policy := CapTotalDelay(300 * time.Millisecond)(ConstantDelay(100 * time.Millisecond))
+
+		assert.Equal(t, []time.Duration{
+			100 * time.Millisecond,
+			100 * time.Millisecond,
+			100 * time.Millisecond,
+		}, delaysOf(policy, 10))
+	})
+
+	t.Run("preserves None from underlying policy", func(t *testing.T) {
+		policy := CapTotalDelay(time.Hour)(LimitRetries(2))
+
+		assert.Len(t, delaysOf(policy, 10), 2)
+	})
+}
+
+func TestCapDelayTo(t *testing.T) {
+	policy := Monoid.Concat(
+		LimitRetries(5),
+		CapDelayTo(300*time.Millisecond)(ExponentialBackoffWithJitter(100*time.Millisecond, func() float64 { return 0.5 })),
+	)
+
+	assert.Equal(t, []time.Duration{
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		300 * time.Millisecond,
+		300 * time.Millisecond,
+	}, delaysOf(policy, 10))
+}
+
+func TestCollect(t *testing.T) {
+	policy, history := Collect(Monoid.Concat(LimitRetries(2), ExponentialBackoff(100*time.Millisecond)))
+
+	assert.Empty(t, history())
+
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, delaysOf(policy, 10))
+
+	assert.Equal(t, []RetryStatus{
+		{IterNumber: 1, CumulativeDelay: 100 * time.Millisecond, PreviousDelay: O.Some(100 * time.Millisecond)},
+		{IterNumber: 2, CumulativeDelay: 300 * time.Millisecond, PreviousDelay: O.Some(200 * time.Millisecond)},
+		{IterNumber: 3, CumulativeDelay: 300 * time.Millisecond, PreviousDelay: O.None[time.Duration]()},
+	}, history())
+}
+
+// policyEq compares retry policies by their decisions for the first iterations
+var policyEq = EQ.FromEquals(func(l, r RetryPolicy) bool {
+	for i := range uint(12) {
+		status := RetryStatus{IterNumber: i, CumulativeDelay: time.Duration(i) * time.Second, PreviousDelay: O.None[time.Duration]()}
+		if !O.Eq(EQ.FromStrictEquals[time.Duration]()).Equals(l(status), r(status)) {
+			return false
+		}
+	}
+	return true
+})
+
+// policyGen generates retry policies from the predefined building blocks
+var policyGen = rapid.Custom(func(t *rapid.T) RetryPolicy {
+	delay := time.Duration(rapid.IntRange(0, 1000).Draw(t, "delay")) * time.Millisecond
+	switch rapid.IntRange(0, 3).Draw(t, "kind") {
+	case 0:
+		return LimitRetries(rapid.UintRange(0, 10).Draw(t, "limit"))
+	case 1:
+		return ConstantDelay(delay)
+	case 2:
+		return ExponentialBackoff(delay)
+	default:
+		return CapDelay(delay, ExponentialBackoff(time.Millisecond))
+	}
+})
+
+func TestMonoidLaws(t *testing.T) {
+	assert.True(t, MT.AssertLawsEq(t, policyEq, Monoid)(LimitRetries(3), ConstantDelay(time.Second), ExponentialBackoff(time.Millisecond)))
+
+	MT.CheckLaws(t, policyEq, Monoid, policyGen)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testing provides helpers to verify that [S.Semigroup] instances satisfy the semigroup laws.
+package testing
+
+import (
+	"testing"
+
+	E "github.com/IBM/fp-go/v2/eq"
+	S "github.com/IBM/fp-go/v2/semigroup"
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+// AssertAssociativity returns a function that asserts the associativity law for a triple of operands
+//
+//	concat(concat(a, b), c) = concat(a, concat(b, c))
+//
+// The failure message names the law and the operands. The assertion target can be a [*testing.T]
+// or a [*rapid.T].
+func AssertAssociativity[A any](t assert.TestingT, eq E.Eq[A], sg S.Semigroup[A]) func(a, b, c A) bool {
+	return func(a, b, c A) bool {
+		return assert.True(t,
+			eq.Equals(sg.Concat(sg.Concat(a, b), c), sg.Concat(a, sg.Concat(b, c))),
+			"Semigroup associativity: concat(concat(a, b), c) = concat(a, concat(b, c)) violated for a=%v, b=%v, c=%v", a, b, c)
+	}
+}
+
+// AssertLaws asserts the semigroup laws for a triple of operands, values are compared using eq
+//
+//	concat(concat(a, b), c) = concat(a, concat(b, c))
+//
+// Example:
+//
+//	laws := AssertLaws(t, eq.FromStrictEquals[int](), number.SemigroupSum[int]())
+//	assert.True(t, laws(1, 2, 3))
+func AssertLaws[A any](t *testing.T, eq E.Eq[A], sg S.Semigroup[A]) func(a, b, c A) bool {
+	return AssertAssociativity(t, eq, sg)
+}
+
+// CheckLaws verifies the semigroup laws for operands produced by the generator using property based testing
+//
+// Example:
+//
+//	CheckLaws(t, eq.FromStrictEquals[int](), number.SemigroupSum[int](), rapid.Int())
+func CheckLaws[A any](t *testing.T, eq E.Eq[A], sg S.Semigroup[A], gen *rapid.Generator[A]) {
+	rapid.Check(t, func(rt *rapid.T) {
+		AssertAssociativity(rt, eq, sg)(gen.Draw(rt, "a"), gen.Draw(rt, "b"), gen.Draw(rt, "c"))
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2023 - 2025 IBM Corp.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	E "github.com/IBM/fp-go/v2/eq"
+	S "github.com/IBM/fp-go/v2/semigroup"
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+)
+
+// recordingT records the failure messages of assertions
+type recordingT struct {
+	messages []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func TestAssertLaws(t *testing.T) {
+	laws := AssertLaws(t, E.FromStrictEquals[string](), S.MakeSemigroup(func(l, r string) string { return l + r }))
+
+	assert.True(t, laws("a", "b", "c"))
+	assert.True(t, laws("", "", ""))
+}
+
+func TestAssertAssociativityFailure(t *testing.T) {
+	rt := &recordingT{}
+	sub := S.MakeSemigroup(func(l, r int) int { return l - r })
+
+	assert.False(t, AssertAssociativity(rt, E.FromStrictEquals[int](), sub)(1, 2, 3))
+	assert.Len(t, rt.messages, 1)
+	assert.Contains(t, rt.messages[0], "Semigroup associativity")
+	assert.Contains(t, rt.messages[0], "a=1, b=2, c=3")
+}
+
+func TestCheckLaws(t *testing.T) {
+	CheckLaws(t, E.FromStrictEquals[int](), S.MakeSemigroup(func(l, r int) int { return max(l, r) }), rapid.Int())
+}